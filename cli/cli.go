@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+)
+
+const usage = `openrarity ranks the tokens of a collection by rarity.
+
+Usage:
+
+	openrarity <command> [flags] <input>
+
+The commands are:
+
+	rank      score and rank every token, and write the ranks
+	explain   show the trait breakdown of the score of one token
+	validate  check whether the collection can be scored
+	stats     print the entropy and the trait distributions of the collection
+
+The input is a directory, a .tar, .tar.gz, .tgz or .zip archive of token metadata files,
+a .csv file or a .jsonl file. Run "openrarity <command> -h" for the flags of a command.
+`
+
+// command is a subcommand of the CLI, it returns the exit code of the process
+type command func(args []string, stdout, stderr io.Writer) int
+
+var commands = map[string]command{
+	"rank":     runRank,
+	"explain":  runExplain,
+	"validate": runValidate,
+	"stats":    runStats,
+}
+
+// Run is used to run the openrarity command-line tool with the given arguments, without
+// the program name. It returns the exit code of the process: 0 on success, 1 when the
+// command fails and 2 when it is misused.
+func Run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "openrarity: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+	return cmd(args[1:], stdout, stderr)
+}
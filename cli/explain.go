@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/pkg/errors"
+)
+
+func runExplain(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("explain", flag.ContinueOnError)
+	var (
+		inputs   inputFlags
+		scorings scoringFlags
+		tokenID  string
+		asJSON   bool
+	)
+	inputs.register(fs)
+	scorings.register(fs, false)
+	fs.StringVar(&tokenID, "token", "",
+		"token to explain, either its token identifier, e.g. evm_contract:0xabc:1, or its last field, e.g. 1")
+	fs.BoolVar(&asJSON, "json", false, "write the explanation as JSON")
+	input, code, ok := parseArgs(fs, args, stderr)
+	if !ok {
+		return code
+	}
+	if tokenID == "" {
+		return fail(stderr, fs.Name(), errors.New("the -token flag is required"))
+	}
+
+	collection, err := inputs.load(input, stderr)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	token, err := findToken(collection, tokenID)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	scorer, err := scorings.scorer()
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	explainer, ok := scorer.(scoring.ITokenExplainer)
+	if !ok {
+		return fail(stderr, fs.Name(), scoring.ErrExplanationUnsupported)
+	}
+	explanation, err := explainer.ExplainToken(collection, token)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+
+	if asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(explanation); err != nil {
+			return fail(stderr, fs.Name(), err)
+		}
+		return 0
+	}
+	fmt.Fprintf(stdout, "token:               %s\n", token.TokenIdentifier())
+	fmt.Fprintf(stdout, "score:               %g\n", explanation.Score)
+	fmt.Fprintf(stdout, "information content: %g bits\n", explanation.InformationContent)
+	fmt.Fprintf(stdout, "entropy normalizer:  %g bits\n\n", explanation.EntropyNormalizer)
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TRAIT\tVALUE\tTOKENS\tPROBABILITY\tBITS")
+	for _, attribute := range explanation.Attributes {
+		value := attribute.Value
+		if attribute.IsNull {
+			value = "(null)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%.6f\t%.6f\n",
+			attribute.Name, value, attribute.TotalTokens, attribute.Probability, attribute.InformationContent,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	return 0
+}
+
+// findToken is used to find a token by its token identifier, or by the last fields of its
+// token identifier, such as the token ID of EVM tokens, which must then match a single token.
+func findToken(collection models.ICollection, value string) (models.IToken, error) {
+	var matches []models.IToken
+	for _, token := range collection.Tokens() {
+		identifier := token.TokenIdentifier().String()
+		if identifier == value {
+			return token, nil
+		}
+		if strings.HasSuffix(identifier, ":"+value) {
+			matches = append(matches, token)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, errors.Errorf("token %q not found", value)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, errors.Errorf("token %q is ambiguous, it matches %d tokens", value, len(matches))
+	}
+}
@@ -0,0 +1,208 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Base-Labs/openrarity"
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/Base-Labs/openrarity/loader"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+	"github.com/pkg/errors"
+)
+
+// defines the input formats
+const (
+	formatAuto     = "auto"
+	formatMetadata = "metadata"
+	formatMetaplex = "metaplex"
+	formatCSV      = "csv"
+	formatJSONL    = "jsonl"
+)
+
+// inputFlags holds the flags selecting and reading the collection
+type inputFlags struct {
+	format          string
+	name            string
+	contractAddress string
+	tokenIDField    string
+	numericBins     string
+	dateWindow      string
+}
+
+func (f *inputFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.format, "format", formatAuto,
+		"input format: auto, metadata (OpenSea JSON files), metaplex (Metaplex JSON files), csv or jsonl")
+	fs.StringVar(&f.name, "name", "", "collection name, defaults to the input file name")
+	fs.StringVar(&f.contractAddress, "contract", "", "contract address of EVM tokens without one")
+	fs.StringVar(&f.tokenIDField, "token-id-field", "",
+		"metadata field holding the token ID, derived from the file name by default")
+	fs.StringVar(&f.numericBins, "numeric-bins", "",
+		"bucket numeric traits: categorical, equal_width:<bins> or quantile:<bins>")
+	fs.StringVar(&f.dateWindow, "date-window", "", "bucket date traits by day, month or year")
+}
+
+// load is used to read the collection, the files of a metadata directory which can't be read
+// are reported to stderr.
+func (f *inputFlags) load(input string, stderr io.Writer) (*models.Collection, error) {
+	collectionOpts, err := f.collectionOptions()
+	if err != nil {
+		return nil, err
+	}
+	name := f.name
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(input), filepath.Ext(input))
+	}
+	format, err := f.detectFormat(input)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case formatCSV, formatJSONL:
+		file, err := os.Open(input)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		opts := []oio.Option{
+			oio.WithContractAddress(f.contractAddress),
+			oio.WithCollectionOptions(collectionOpts...),
+		}
+		if format == formatCSV {
+			return oio.ReadCSVCollection(file, name, opts...)
+		}
+		return oio.ReadJSONLCollection(file, name, opts...)
+	default:
+		opts := []loader.Option{
+			loader.WithContractAddress(f.contractAddress),
+			loader.WithCollectionOptions(collectionOpts...),
+		}
+		if f.name != "" {
+			opts = append(opts, loader.WithCollectionName(f.name))
+		}
+		if f.tokenIDField != "" {
+			opts = append(opts, loader.WithTokenIDField(f.tokenIDField))
+		}
+		if format == formatMetaplex {
+			opts = append(opts, loader.WithTokenFactory(loader.MetaplexTokenFactory))
+		}
+		collection, report, err := loader.Load(input, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileErr := range report.Errors {
+			fmt.Fprintf(stderr, "skipped %v\n", fileErr)
+		}
+		return collection, nil
+	}
+}
+
+func (f *inputFlags) detectFormat(input string) (string, error) {
+	switch f.format {
+	case formatMetadata, formatMetaplex, formatCSV, formatJSONL:
+		return f.format, nil
+	case formatAuto:
+	default:
+		return "", errors.Errorf("unknown input format %q", f.format)
+	}
+	switch strings.ToLower(filepath.Ext(input)) {
+	case ".csv":
+		return formatCSV, nil
+	case ".jsonl", ".ndjson":
+		return formatJSONL, nil
+	default:
+		return formatMetadata, nil
+	}
+}
+
+func (f *inputFlags) collectionOptions() ([]models.CollectionOption, error) {
+	var opts []models.CollectionOption
+	if f.numericBins != "" {
+		binner, err := parseNumericBinner(f.numericBins)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, models.WithNumericBinner(binner))
+	}
+	switch models.DateWindow(f.dateWindow) {
+	case "":
+	case models.DateWindowDay, models.DateWindowMonth, models.DateWindowYear:
+		opts = append(opts, models.WithDateBinner(models.NewDateWindowBinner(models.DateWindow(f.dateWindow))))
+	default:
+		return nil, errors.Errorf("unknown date window %q", f.dateWindow)
+	}
+	return opts, nil
+}
+
+func parseNumericBinner(value string) (models.INumericBinner, error) {
+	if value == "categorical" {
+		return models.NewCategoricalBinner(), nil
+	}
+	kind, bins, _ := strings.Cut(value, ":")
+	n, err := strconv.Atoi(bins)
+	if err != nil || n < 1 {
+		return nil, errors.Errorf("invalid numeric bins %q", value)
+	}
+	switch kind {
+	case "equal_width":
+		return models.NewEqualWidthBinner(n), nil
+	case "quantile":
+		return models.NewQuantileBinner(n), nil
+	default:
+		return nil, errors.Errorf("invalid numeric bins %q", value)
+	}
+}
+
+// scoringFlags holds the flags selecting how tokens are scored and ranked
+type scoringFlags struct {
+	handler string
+	mode    string
+}
+
+func (f *scoringFlags) register(fs *flag.FlagSet, ranking bool) {
+	fs.StringVar(&f.handler, "handler", handlers.InformationContentHandlerName,
+		"scoring handler: "+strings.Join(scoring.ScoreHandlerNames(), ", "))
+	if ranking {
+		fs.StringVar(&f.mode, "mode", string(openrarity.RankingModeRank),
+			"ranking mode of tied tokens: rank, dense_rank or ordinal")
+	}
+}
+
+func (f *scoringFlags) scorer() (scoring.IScorer, error) {
+	return openrarity.NewScorerByName(f.handler)
+}
+
+func (f *scoringFlags) ranker() *openrarity.RarityRanker {
+	return openrarity.NewRarityRanker(openrarity.WithRankingMode(openrarity.RankingMode(f.mode)))
+}
+
+// parseArgs is used to parse the flags of a command taking a single input, it returns
+// false if the process should exit with the returned code.
+func parseArgs(fs *flag.FlagSet, args []string, stderr io.Writer) (string, int, bool) {
+	fs.SetOutput(stderr)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return "", 0, false
+		}
+		return "", 2, false
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintf(stderr, "openrarity %s: expected one input, got %d\n", fs.Name(), fs.NArg())
+		fs.Usage()
+		return "", 2, false
+	}
+	return fs.Arg(0), 0, true
+}
+
+// fail is used to report an error and get the exit code of a failed command
+func fail(stderr io.Writer, name string, err error) int {
+	fmt.Fprintf(stderr, "openrarity %s: %v\n", name, err)
+	return 1
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/pkg/errors"
+)
+
+func runRank(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("rank", flag.ContinueOnError)
+	var (
+		inputs        inputFlags
+		scorings      scoringFlags
+		output        string
+		outputFormat  string
+		features      bool
+		probabilities bool
+	)
+	inputs.register(fs)
+	scorings.register(fs, true)
+	fs.StringVar(&output, "o", "", "output file, defaults to the standard output")
+	fs.StringVar(&outputFormat, "output-format", "",
+		"output format: json, jsonl or csv, defaults to the extension of the output file or json")
+	fs.BoolVar(&features, "features", false, "write every ranking feature of the tokens")
+	fs.BoolVar(&probabilities, "probabilities", false, "write the value and the probability of every trait of the tokens")
+	input, code, ok := parseArgs(fs, args, stderr)
+	if !ok {
+		return code
+	}
+
+	if outputFormat == "" {
+		outputFormat = strings.TrimPrefix(strings.ToLower(filepath.Ext(output)), ".")
+	}
+	write := oio.WriteJSON
+	switch outputFormat {
+	case "", "json":
+	case "jsonl":
+		write = oio.WriteJSONL
+	case "csv":
+		write = oio.WriteCSV
+	default:
+		return fail(stderr, fs.Name(), errors.Errorf("unknown output format %q", outputFormat))
+	}
+	collection, err := inputs.load(input, stderr)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	scorer, err := scorings.scorer()
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	tokenRarities, err := scorings.ranker().RankCollection(collection, scorer)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+
+	var opts []oio.WriterOption
+	if features {
+		opts = append(opts, oio.WithFeatures())
+	}
+	if probabilities {
+		opts = append(opts, oio.WithTraitProbabilities(collection))
+	}
+	if output == "" {
+		if err := write(stdout, tokenRarities, opts...); err != nil {
+			return fail(stderr, fs.Name(), err)
+		}
+		return 0
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	if err := write(file, tokenRarities, opts...); err != nil {
+		_ = file.Close()
+		return fail(stderr, fs.Name(), err)
+	}
+	// the ranks may only be flushed to disk on close
+	if err := file.Close(); err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	return 0
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+)
+
+// collectionStats holds the entropy and the trait distributions of a collection
+type collectionStats struct {
+	Name        string        `json:"name"`
+	Tokens      int           `json:"tokens"`
+	TotalSupply int           `json:"total_supply"`
+	Entropy     float64       `json:"entropy"`
+	Traits      []*traitStats `json:"traits"`
+}
+
+// traitStats holds the distribution of the values of a trait, including "Null" for the tokens
+// without the trait.
+type traitStats struct {
+	Name    string        `json:"name"`
+	Entropy float64       `json:"entropy"`
+	Values  []*valueStats `json:"values"`
+}
+
+type valueStats struct {
+	Value       string  `json:"value"`
+	Tokens      int     `json:"tokens"`
+	Probability float64 `json:"probability"`
+}
+
+func runStats(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	var (
+		inputs inputFlags
+		asJSON bool
+	)
+	inputs.register(fs)
+	fs.BoolVar(&asJSON, "json", false, "write the statistics as JSON")
+	input, code, ok := parseArgs(fs, args, stderr)
+	if !ok {
+		return code
+	}
+
+	collection, err := inputs.load(input, stderr)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	stats := newCollectionStats(collection)
+
+	if asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(stats); err != nil {
+			return fail(stderr, fs.Name(), err)
+		}
+		return 0
+	}
+	fmt.Fprintf(stdout, "collection:   %s\n", stats.Name)
+	fmt.Fprintf(stdout, "tokens:       %d\n", stats.Tokens)
+	fmt.Fprintf(stdout, "total supply: %d\n", stats.TotalSupply)
+	fmt.Fprintf(stdout, "entropy:      %g bits\n", stats.Entropy)
+	for _, trait := range stats.Traits {
+		fmt.Fprintf(stdout, "\n%s: %d values, %g bits\n", trait.Name, len(trait.Values), trait.Entropy)
+		w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+		for _, value := range trait.Values {
+			fmt.Fprintf(w, "  %s\t%d\t%.6f\n", value.Value, value.Tokens, value.Probability)
+		}
+		if err := w.Flush(); err != nil {
+			return fail(stderr, fs.Name(), err)
+		}
+	}
+	return 0
+}
+
+// newCollectionStats is used to compute the statistics of a collection, with traits sorted by
+// name and values sorted by decreasing number of tokens.
+func newCollectionStats(collection *models.Collection) *collectionStats {
+	attributes := collection.ExtractCollectionAttributes()
+	nullAttributes := collection.ExtractNullAttributes()
+	supply := float64(collection.TokenTotalSupply())
+	stats := &collectionStats{
+		Name:        collection.Name(),
+		Tokens:      len(collection.Tokens()),
+		TotalSupply: collection.TokenTotalSupply(),
+		Entropy: handlers.NewInformationContentScoringHandler().
+			GetCollectionEntropy(collection, attributes, nullAttributes),
+	}
+	for name, values := range attributes {
+		if nullAttribute := nullAttributes[name]; nullAttribute != nil {
+			values = append(values, nullAttribute)
+		}
+		trait := &traitStats{Name: name}
+		for _, value := range values {
+			probability := float64(value.TotalTokens) / supply
+			trait.Entropy -= probability * math.Log2(probability)
+			trait.Values = append(trait.Values, &valueStats{
+				Value:       value.Attribute.Value(),
+				Tokens:      value.TotalTokens,
+				Probability: probability,
+			})
+		}
+		sort.Slice(trait.Values, func(i, j int) bool {
+			if trait.Values[i].Tokens != trait.Values[j].Tokens {
+				return trait.Values[i].Tokens > trait.Values[j].Tokens
+			}
+			return trait.Values[i].Value < trait.Values[j].Value
+		})
+		stats.Traits = append(stats.Traits, trait)
+	}
+	sort.Slice(stats.Traits, func(i, j int) bool {
+		return stats.Traits[i].Name < stats.Traits[j].Name
+	})
+	return stats
+}
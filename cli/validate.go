@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/pkg/errors"
+)
+
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	var (
+		inputs      inputFlags
+		scorings    scoringFlags
+		minSize     int
+		maxDistinct int
+		strict      bool
+	)
+	inputs.register(fs)
+	scorings.register(fs, false)
+	fs.IntVar(&minSize, "min-size", 0, "also require the given number of tokens")
+	fs.IntVar(&maxDistinct, "max-distinct-values", 0, "also limit the number of distinct values of every trait")
+	fs.BoolVar(&strict, "strict", false, "also reject mixed identifier types and tokens without traits")
+	input, code, ok := parseArgs(fs, args, stderr)
+	if !ok {
+		return code
+	}
+
+	collection, err := inputs.load(input, stderr)
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	validators := scoring.DefaultValidators()
+	if minSize > 0 {
+		validators = append(validators, scoring.NewMinCollectionSizeValidator(minSize))
+	}
+	if maxDistinct > 0 {
+		validators = append(validators, scoring.NewMaxDistinctValuesValidator(maxDistinct))
+	}
+	if strict {
+		validators = append(validators,
+			scoring.NewMixedIdentifiersValidator(),
+			scoring.NewEmptyTokenMetadataValidator(),
+		)
+	}
+	scorer, err := openrarity.NewScorerByName(scorings.handler, scoring.WithValidators(validators...))
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+
+	err = scorer.ValidateCollection(collection)
+	var report *scoring.ValidationReport
+	if errors.As(err, &report) {
+		for _, violation := range report.Violations {
+			if violation.Token != nil {
+				fmt.Fprintf(stdout, "%s: %s: %s\n", violation.Rule, violation.Token.TokenIdentifier(), violation.Message)
+			} else {
+				fmt.Fprintf(stdout, "%s: %s\n", violation.Rule, violation.Message)
+			}
+		}
+		return 1
+	}
+	if err != nil {
+		return fail(stderr, fs.Name(), err)
+	}
+	fmt.Fprintf(stdout, "ok: %d tokens can be scored\n", len(collection.Tokens()))
+	return 0
+}
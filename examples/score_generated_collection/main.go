@@ -15,30 +15,30 @@ func must[V any](value V, err error) V {
 
 func main() {
 	scorer := openrarity.NewOpenRarityScorer()
-	collection := openrarity.NewCollection(
+	collection := must(openrarity.NewCollection(
 		"My Collection Name",
 		[]openrarity.IToken{
-			must(openrarity.NewERC721Token("0xa3049...", 1, map[string]interface{}{
+			must(openrarity.NewERC721Token("0xa3049d5a3cbbb1c1fc94ab54ec5a2e2d9e8f9a5b", 1, map[string]interface{}{
 				"hat":   "cap",
 				"shirt": "blue",
 			})),
-			must(openrarity.NewERC721Token("0xa3049...", 2, map[string]interface{}{
+			must(openrarity.NewERC721Token("0xa3049d5a3cbbb1c1fc94ab54ec5a2e2d9e8f9a5b", 2, map[string]interface{}{
 				"hat":   "visor",
 				"shirt": "green",
 			})),
-			must(openrarity.NewERC721Token("0xa3049...", 3, map[string]interface{}{
+			must(openrarity.NewERC721Token("0xa3049d5a3cbbb1c1fc94ab54ec5a2e2d9e8f9a5b", 3, map[string]interface{}{
 				"hat":   "visor",
 				"shirt": "blue",
 				"color": "blue",
 			})),
 		},
-	)
+	))
 	ranker := openrarity.NewRarityRanker()
 	rankedTokens, err := ranker.RankCollection(collection, scorer)
 	if err != nil {
 		panic(err)
 	}
 	for _, token := range rankedTokens {
-		fmt.Println(token.Token().TokenIdentifier(), token.Rank(), token.Score())
+		fmt.Println(token.Token().TokenIdentifier().String(), token.Rank(), token.Score())
 	}
 }
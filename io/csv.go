@@ -0,0 +1,117 @@
+package io
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/pkg/errors"
+)
+
+// CSVReader streams tokens from a wide CSV document, holding a header row followed by one
+// row per token. The identifier columns identify the token, and every other column is a trait.
+// A row identifying the same token as a previous row is rejected.
+type CSVReader struct {
+	reader *csv.Reader
+	config *config
+	header []string
+	lines  tokenLines
+}
+
+// NewCSVReader is the constructor of CSVReader
+func NewCSVReader(r io.Reader, opts ...Option) *CSVReader {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	return &CSVReader{
+		reader: reader,
+		config: newConfig(opts),
+		lines:  tokenLines{},
+	}
+}
+
+// Read is used to read the next token, it returns io.EOF when there are no more tokens.
+// Errors of a single row are returned as *RecordError, reading can go on with the next row.
+func (c *CSVReader) Read() (*models.Token, error) {
+	if c.header == nil {
+		header, err := c.reader.Read()
+		if err != nil {
+			return nil, err
+		}
+		c.header = append([]string(nil), header...)
+	}
+	row, err := c.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &RecordError{Line: parseErr.StartLine, Err: parseErr.Err}
+		}
+		return nil, err
+	}
+	line, _ := c.reader.FieldPos(0)
+	token, err := c.newToken(row)
+	if err == nil {
+		err = c.lines.add(token, line)
+	}
+	if err != nil {
+		return nil, &RecordError{Line: line, Err: err}
+	}
+	return token, nil
+}
+
+func (c *CSVReader) newToken(row []string) (*models.Token, error) {
+	fields := make(map[string]string, len(c.header))
+	attributes := make(map[string]interface{}, len(c.header))
+	for idx, column := range c.header {
+		value := row[idx]
+		if c.config.isIdentifierColumn(column) {
+			fields[column] = value
+			continue
+		}
+		if value == "" && !c.config.keepEmptyValues {
+			continue
+		}
+		converted, err := c.config.convertCell(column, value)
+		if err != nil {
+			return nil, err
+		}
+		attributes[column] = converted
+	}
+	metadata, err := models.NewTokenMetadataFromAttributes(attributes)
+	if err != nil {
+		return nil, err
+	}
+	return c.config.newToken(fields, metadata)
+}
+
+// ReadCSVCollection is used to read every token of a wide CSV document into a collection,
+// it fails on the first row which can't be read.
+func ReadCSVCollection(r io.Reader, name string, opts ...Option) (*models.Collection, error) {
+	reader := NewCSVReader(r, opts...)
+	return readCollection(reader, name, reader.config.collectionOptions)
+}
+
+// tokenReader is implemented by the readers streaming tokens
+type tokenReader interface {
+	Read() (*models.Token, error)
+}
+
+func readCollection(
+	reader tokenReader,
+	name string,
+	collectionOpts []models.CollectionOption,
+) (*models.Collection, error) {
+	var tokens []models.IToken
+	for {
+		token, err := reader.Read()
+		if err == io.EOF {
+			return models.NewCollection(name, tokens, collectionOpts...)
+		}
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+}
@@ -0,0 +1,214 @@
+package io
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// defines the prefixes of the optional CSV columns
+const (
+	FeatureColumnPrefix     = "feature:"
+	ValueColumnPrefix       = "value:"
+	ProbabilityColumnPrefix = "probability:"
+)
+
+// RankedToken is the exported record of a ranked token. Its token_id, rank and score fields follow
+// the output of the reference Python implementation, see https://github.com/OpenRarity/open-rarity
+type RankedToken struct {
+	// TokenID is the token ID of EVM and Tezos tokens, as a number, or the canonical form of the
+	// token identifier, see models.ITokenIdentifier, as a string for other tokens.
+	TokenID              json.RawMessage             `json:"token_id"`
+	Rank                 int                         `json:"rank"`
+	Score                float64                     `json:"score"`
+	UniqueAttributeCount int                         `json:"unique_attribute_count"`
+	TokenIdentifier      models.ITokenIdentifier     `json:"token_identifier"`
+	TokenStandard        models.TokenStandard        `json:"token_standard"`
+	Features             map[string]float64          `json:"features,omitempty"`
+	TraitProbabilities   map[string]TraitProbability `json:"trait_probabilities,omitempty"`
+}
+
+// UnmarshalJSON is used to decode the record, with the token identifier of any type
+func (r *RankedToken) UnmarshalJSON(data []byte) error {
+	type rankedToken RankedToken
+	var v struct {
+		*rankedToken
+		TokenIdentifier json.RawMessage `json:"token_identifier"`
+	}
+	v.rankedToken = (*rankedToken)(r)
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	identifier, err := models.UnmarshalTokenIdentifierJSON(v.TokenIdentifier)
+	if err != nil {
+		return err
+	}
+	r.TokenIdentifier = identifier
+	return nil
+}
+
+// TraitProbability is the probability of a token having its value of a trait, which is "Null"
+// if the token doesn't have the trait.
+type TraitProbability struct {
+	Value       string  `json:"value"`
+	Probability float64 `json:"probability"`
+}
+
+// WriterOption is used to configure the optional fields of the exported records
+type WriterOption func(c *writerConfig)
+
+type writerConfig struct {
+	features   bool
+	collection models.ICollection
+}
+
+// WithFeatures is used to export every ranking feature of the tokens
+func WithFeatures() WriterOption {
+	return func(c *writerConfig) {
+		c.features = true
+	}
+}
+
+// WithTraitProbabilities is used to export the probability of every trait of the tokens in the
+// given collection, including the probability of not having the traits the tokens miss.
+func WithTraitProbabilities(collection models.ICollection) WriterOption {
+	return func(c *writerConfig) {
+		c.collection = collection
+	}
+}
+
+// NewRankedTokens is used to build the exported records of ranked tokens, in the given order
+func NewRankedTokens(tokenRarities []models.ITokenRarity, opts ...WriterOption) []*RankedToken {
+	c := &writerConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	var nullAttributes map[models.AttributeName]*models.CollectionAttribute
+	if c.collection != nil {
+		nullAttributes = c.collection.ExtractNullAttributes()
+	}
+	records := make([]*RankedToken, 0, len(tokenRarities))
+	for _, tokenRarity := range tokenRarities {
+		token := tokenRarity.Token()
+		record := &RankedToken{
+			TokenID:              exportedTokenID(token.TokenIdentifier()),
+			Rank:                 tokenRarity.Rank(),
+			Score:                tokenRarity.Score(),
+			UniqueAttributeCount: tokenRarity.TokenFeatures().UniqueAttributeCount(),
+			TokenIdentifier:      token.TokenIdentifier(),
+			TokenStandard:        token.TokenStandard(),
+		}
+		if c.features {
+			record.Features = tokenRarity.TokenFeatures().Features()
+		}
+		if c.collection != nil {
+			supply := float64(c.collection.TokenTotalSupply())
+			attributes := scoring.GetTokenCollectionAttributes(c.collection, token, nullAttributes)
+			record.TraitProbabilities = make(map[string]TraitProbability, len(attributes))
+			for _, attribute := range attributes {
+				record.TraitProbabilities[attribute.Attribute.Name()] = TraitProbability{
+					Value:       attribute.Attribute.Value(),
+					Probability: float64(attribute.TotalTokens) / supply,
+				}
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// exportedTokenID is used to get the token ID of EVM and Tezos tokens as a JSON number, and the
+// canonical form of other identifiers as a JSON string.
+func exportedTokenID(identifier models.ITokenIdentifier) json.RawMessage {
+	if v, ok := identifier.(interface{ TokenID() *big.Int }); ok {
+		return json.RawMessage(v.TokenID().String())
+	}
+	data, _ := json.Marshal(identifier.String())
+	return data
+}
+
+// WriteJSON is used to write ranked tokens as a JSON array of RankedToken records
+func WriteJSON(w io.Writer, tokenRarities []models.ITokenRarity, opts ...WriterOption) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(NewRankedTokens(tokenRarities, opts...))
+}
+
+// WriteJSONL is used to write ranked tokens as JSON Lines, one RankedToken record per line
+func WriteJSONL(w io.Writer, tokenRarities []models.ITokenRarity, opts ...WriterOption) error {
+	encoder := json.NewEncoder(w)
+	for _, record := range NewRankedTokens(tokenRarities, opts...) {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV is used to write ranked tokens as CSV, with the token_id, rank, score,
+// unique_attribute_count, token_identifier and token_standard columns, followed by one
+// column per feature and, if trait probabilities are requested, a value and a probability
+// column per trait, sorted by name.
+func WriteCSV(w io.Writer, tokenRarities []models.ITokenRarity, opts ...WriterOption) error {
+	records := NewRankedTokens(tokenRarities, opts...)
+	featureNames := models.NewSet[string](0)
+	traitNames := models.NewSet[string](0)
+	for _, record := range records {
+		for name := range record.Features {
+			featureNames.Add(name)
+		}
+		for name := range record.TraitProbabilities {
+			traitNames.Add(name)
+		}
+	}
+	features, traits := featureNames.List(), traitNames.List()
+	sort.Strings(features)
+	sort.Strings(traits)
+	header := []string{"token_id", "rank", "score", "unique_attribute_count", "token_identifier", "token_standard"}
+	for _, name := range features {
+		header = append(header, FeatureColumnPrefix+name)
+	}
+	for _, name := range traits {
+		header = append(header, ValueColumnPrefix+name, ProbabilityColumnPrefix+name)
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, record := range records {
+		tokenID := string(record.TokenID)
+		if unquoted, err := strconv.Unquote(tokenID); err == nil {
+			tokenID = unquoted
+		}
+		row := []string{
+			tokenID,
+			strconv.Itoa(record.Rank),
+			formatFloat(record.Score),
+			strconv.Itoa(record.UniqueAttributeCount),
+			record.TokenIdentifier.String(),
+			string(record.TokenStandard),
+		}
+		for _, name := range features {
+			row = append(row, formatFloat(record.Features[name]))
+		}
+		for _, name := range traits {
+			probability := record.TraitProbabilities[name]
+			row = append(row, probability.Value, formatFloat(probability.Probability))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,167 @@
+package io
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/pkg/errors"
+)
+
+// AttributesField is the JSON field which may hold the traits of a token, either as an OpenSea
+// attributes array or as an object mapping trait names to values.
+const AttributesField = "attributes"
+
+// metadataFields holds the fields of the OpenSea and Metaplex metadata standards which describe
+// a token without being traits, they are not read as traits of tokens without attributes field.
+var metadataFields = map[string]struct{}{
+	"name":                    {},
+	"description":             {},
+	"image":                   {},
+	"image_data":              {},
+	"image_url":               {},
+	"external_url":            {},
+	"animation_url":           {},
+	"background_color":        {},
+	"youtube_url":             {},
+	"symbol":                  {},
+	"seller_fee_basis_points": {},
+	"properties":              {},
+	"collection":              {},
+}
+
+// JSONLReader streams tokens from a JSON Lines document, holding one JSON object per token.
+// The identifier fields identify the token, its traits are read from its attributes field
+// if any, or from every other field otherwise, except the standard metadata fields such as
+// name, description or image. Blank lines are skipped, and a line identifying the same token
+// as a previous line is rejected.
+type JSONLReader struct {
+	scanner *bufio.Scanner
+	config  *config
+	line    int
+	lines   tokenLines
+}
+
+// NewJSONLReader is the constructor of JSONLReader
+func NewJSONLReader(r io.Reader, opts ...Option) *JSONLReader {
+	scanner := bufio.NewScanner(r)
+	// tokens with many traits don't fit in the default buffer of 64KB
+	scanner.Buffer(nil, 16*1024*1024)
+	return &JSONLReader{
+		scanner: scanner,
+		config:  newConfig(opts),
+		lines:   tokenLines{},
+	}
+}
+
+// Read is used to read the next token, it returns io.EOF when there are no more tokens.
+// Errors of a single line are returned as *RecordError, reading can go on with the next line.
+func (c *JSONLReader) Read() (*models.Token, error) {
+	for c.scanner.Scan() {
+		c.line++
+		line := bytes.TrimSpace(c.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		token, err := c.newToken(line)
+		if err == nil {
+			err = c.lines.add(token, c.line)
+		}
+		if err != nil {
+			return nil, &RecordError{Line: c.line, Err: err}
+		}
+		return token, nil
+	}
+	if err := c.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (c *JSONLReader) newToken(line []byte) (*models.Token, error) {
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+	var record map[string]interface{}
+	if err := decoder.Decode(&record); err != nil {
+		return nil, errors.Wrap(models.ErrInvalidTokenMetadata, err.Error())
+	}
+	fields := map[string]string{}
+	traits := map[string]interface{}{}
+	for name, value := range record {
+		if c.config.isIdentifierColumn(name) {
+			switch v := value.(type) {
+			case string:
+				fields[name] = v
+			case json.Number:
+				fields[name] = v.String()
+			}
+			continue
+		}
+		if _, exists := metadataFields[name]; exists {
+			continue
+		}
+		traits[name] = value
+	}
+	var (
+		metadata *models.TokenMetadata
+		err      error
+	)
+	switch attributes := record[AttributesField].(type) {
+	case []interface{}:
+		metadata, err = c.newMetadataFromArray(attributes)
+	case map[string]interface{}:
+		metadata, err = c.newMetadataFromMap(attributes)
+	case nil:
+		metadata, err = c.newMetadataFromMap(traits)
+	default:
+		err = errors.Wrapf(models.ErrInvalidTokenMetadata, "%q must be an array or an object", AttributesField)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.config.newToken(fields, metadata)
+}
+
+func (c *JSONLReader) newMetadataFromMap(traits map[string]interface{}) (*models.TokenMetadata, error) {
+	attributes := make(map[string]interface{}, len(traits))
+	for name, value := range traits {
+		converted, ok, err := c.config.convertJSONValue(name, value)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			attributes[name] = converted
+		}
+	}
+	return models.NewTokenMetadataFromAttributes(attributes)
+}
+
+func (c *JSONLReader) newMetadataFromArray(items []interface{}) (*models.TokenMetadata, error) {
+	attributes := make([]models.OpenSeaAttribute, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.Wrapf(models.ErrInvalidTokenMetadata, "attribute %v must be an object", item)
+		}
+		if value, ok := fields["value"].(string); ok && value == "" && !c.config.keepEmptyValues {
+			continue
+		}
+		traitType, _ := fields["trait_type"].(string)
+		displayType, _ := fields["display_type"].(string)
+		attributes = append(attributes, models.OpenSeaAttribute{
+			TraitType:   traitType,
+			Value:       fields["value"],
+			DisplayType: displayType,
+		})
+	}
+	return models.NewTokenMetadataFromOpenSeaAttributes(attributes)
+}
+
+// ReadJSONLCollection is used to read every token of a JSON Lines document into a collection,
+// it fails on the first line which can't be read.
+func ReadJSONLCollection(r io.Reader, name string, opts ...Option) (*models.Collection, error) {
+	reader := NewJSONLReader(r, opts...)
+	return readCollection(reader, name, reader.config.collectionOptions)
+}
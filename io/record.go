@@ -0,0 +1,300 @@
+package io
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/pkg/errors"
+)
+
+// defines the default names of the columns, or JSON fields, identifying tokens
+const (
+	DefaultIdentifierColumn      = "token_identifier"
+	DefaultContractAddressColumn = "contract_address"
+	DefaultTokenIDColumn         = "token_id"
+	DefaultMintAddressColumn     = "mint_address"
+	DefaultTokenStandardColumn   = "token_standard"
+)
+
+// defines a set of errors returned by the readers
+var (
+	// ErrMissingTokenIdentifier is returned when a record holds none of the identifier columns
+	ErrMissingTokenIdentifier = errors.New("record has no token identifier")
+	// ErrDuplicateToken is returned when a record holds the token identifier of a previous record
+	ErrDuplicateToken = errors.New("duplicate token")
+)
+
+// RecordError describes a record which couldn't be turned into a token
+type RecordError struct {
+	// Line is the line number of the record, starting at 1
+	Line int
+	// Err is the reason why the record couldn't be read
+	Err error
+}
+
+// Error is used to describe the record error
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap is used to get the reason why the record couldn't be read
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// tokenLines holds the line of the record of every token read, by canonical token identifier
+type tokenLines map[string]int
+
+// add is used to record the line of a token, it fails if the token was already read
+func (l tokenLines) add(token models.IToken, line int) error {
+	identifier := token.TokenIdentifier().String()
+	if previousLine, exists := l[identifier]; exists {
+		return errors.Wrapf(ErrDuplicateToken, "%s already read at line %d", identifier, previousLine)
+	}
+	l[identifier] = line
+	return nil
+}
+
+// Option is used to configure how records are turned into tokens
+type Option func(c *config)
+
+// config holds how records are turned into tokens
+type config struct {
+	identifierColumn      string
+	contractAddressColumn string
+	tokenIDColumn         string
+	mintAddressColumn     string
+	tokenStandardColumn   string
+	contractAddress       string
+	tokenStandard         models.TokenStandard
+	keepEmptyValues       bool
+	numericColumns        map[string]bool
+	dateColumns           map[string]bool
+	collectionOptions     []models.CollectionOption
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		identifierColumn:      DefaultIdentifierColumn,
+		contractAddressColumn: DefaultContractAddressColumn,
+		tokenIDColumn:         DefaultTokenIDColumn,
+		mintAddressColumn:     DefaultMintAddressColumn,
+		tokenStandardColumn:   DefaultTokenStandardColumn,
+		numericColumns:        map[string]bool{},
+		dateColumns:           map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithIdentifierColumn is used to read the canonical form of token identifiers, see
+// models.ParseTokenIdentifier, from the given column. It takes precedence over the other
+// identifier columns.
+func WithIdentifierColumn(column string) Option {
+	return func(c *config) {
+		c.identifierColumn = column
+	}
+}
+
+// WithEVMIdentifierColumns is used to read the contract address and the token ID of EVM tokens
+// from the given columns.
+func WithEVMIdentifierColumns(contractAddressColumn, tokenIDColumn string) Option {
+	return func(c *config) {
+		c.contractAddressColumn = contractAddressColumn
+		c.tokenIDColumn = tokenIDColumn
+	}
+}
+
+// WithMintAddressColumn is used to read the mint address of Solana tokens from the given column
+func WithMintAddressColumn(column string) Option {
+	return func(c *config) {
+		c.mintAddressColumn = column
+	}
+}
+
+// WithTokenStandardColumn is used to read the standard of tokens from the given column
+func WithTokenStandardColumn(column string) Option {
+	return func(c *config) {
+		c.tokenStandardColumn = column
+	}
+}
+
+// WithContractAddress is used to set the contract address of EVM tokens whose record has
+// no contract address column.
+func WithContractAddress(contractAddress string) Option {
+	return func(c *config) {
+		c.contractAddress = contractAddress
+	}
+}
+
+// WithTokenStandard is used to set the standard of tokens whose record has no token standard,
+// which defaults to the non-fungible standard of the chain of their identifier.
+func WithTokenStandard(tokenStandard models.TokenStandard) Option {
+	return func(c *config) {
+		c.tokenStandard = tokenStandard
+	}
+}
+
+// WithEmptyValues is used to keep empty values as string attributes, by default an empty
+// cell or an empty JSON string means the token doesn't have the attribute.
+func WithEmptyValues() Option {
+	return func(c *config) {
+		c.keepEmptyValues = true
+	}
+}
+
+// WithNumericColumns is used to read the given trait columns as numeric attributes
+func WithNumericColumns(columns ...string) Option {
+	return func(c *config) {
+		for _, column := range columns {
+			c.numericColumns[column] = true
+		}
+	}
+}
+
+// WithDateColumns is used to read the given trait columns as date attributes, holding a unix
+// timestamp in seconds or a RFC3339 string.
+func WithDateColumns(columns ...string) Option {
+	return func(c *config) {
+		for _, column := range columns {
+			c.dateColumns[column] = true
+		}
+	}
+}
+
+// WithCollectionOptions is used to configure the collections built from the read tokens
+func WithCollectionOptions(opts ...models.CollectionOption) Option {
+	return func(c *config) {
+		c.collectionOptions = append(c.collectionOptions, opts...)
+	}
+}
+
+// isIdentifierColumn is used to determine whether the column identifies the token rather than
+// holding a trait.
+func (c *config) isIdentifierColumn(column string) bool {
+	switch column {
+	case c.identifierColumn, c.contractAddressColumn, c.tokenIDColumn, c.mintAddressColumn, c.tokenStandardColumn:
+		return true
+	default:
+		return false
+	}
+}
+
+// newToken is used to build a token from the identifier fields of a record and its attributes
+func (c *config) newToken(fields map[string]string, metadata models.ITokenMetadata) (*models.Token, error) {
+	identifier, err := c.newTokenIdentifier(fields)
+	if err != nil {
+		return nil, err
+	}
+	tokenStandard := models.TokenStandard(fields[c.tokenStandardColumn])
+	if tokenStandard == "" {
+		tokenStandard = c.tokenStandard
+	}
+	if tokenStandard == "" {
+		tokenStandard = defaultTokenStandard(identifier.IdentifierType())
+	}
+	return models.NewToken(identifier, tokenStandard, metadata), nil
+}
+
+func (c *config) newTokenIdentifier(fields map[string]string) (models.ITokenIdentifier, error) {
+	if value := fields[c.identifierColumn]; value != "" {
+		return models.ParseTokenIdentifier(value)
+	}
+	if value := fields[c.mintAddressColumn]; value != "" {
+		return models.NewSolanaMintAddressTokenIdentifier(value), nil
+	}
+	if tokenID := fields[c.tokenIDColumn]; tokenID != "" {
+		contractAddress := fields[c.contractAddressColumn]
+		if contractAddress == "" {
+			contractAddress = c.contractAddress
+		}
+		return models.ParseEVMContractTokenIdentifier(contractAddress, tokenID)
+	}
+	return nil, ErrMissingTokenIdentifier
+}
+
+// defaultTokenStandard is used to get the non-fungible standard of the chain of an identifier type
+func defaultTokenStandard(identifierType models.IdentifierType) models.TokenStandard {
+	switch identifierType {
+	case models.IdentifierTypeSolanaMintAddress:
+		return models.TokenStandardMetaplexNonFungible
+	case models.IdentifierTypeTezosFA2:
+		return models.TokenStandardFA2NonFungible
+	case models.IdentifierTypeOrdinalsInscription:
+		return models.TokenStandardOrdinalsInscription
+	case models.IdentifierTypeAptosObject:
+		return models.TokenStandardAptosDigitalAsset
+	case models.IdentifierTypeSuiObject:
+		return models.TokenStandardSuiObject
+	case models.IdentifierTypeCosmosCW721:
+		return models.TokenStandardCW721
+	default:
+		return models.TokenStandardERC721
+	}
+}
+
+// convertCell is used to convert the text of a trait cell to a value accepted by
+// models.NewTokenMetadataFromAttributes, according to the configured column types.
+func (c *config) convertCell(column, value string) (interface{}, error) {
+	switch {
+	case c.numericColumns[column]:
+		return parseNumber(column, strings.TrimSpace(value))
+	case c.dateColumns[column]:
+		return parseDate(column, strings.TrimSpace(value))
+	default:
+		return value, nil
+	}
+}
+
+// convertJSONValue is used to convert a trait value decoded with json.Decoder.UseNumber to a value
+// accepted by models.NewTokenMetadataFromAttributes, it returns false if the trait is absent.
+func (c *config) convertJSONValue(field string, value interface{}) (interface{}, bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false, nil
+	case string:
+		if v == "" && !c.keepEmptyValues {
+			return nil, false, nil
+		}
+		converted, err := c.convertCell(field, v)
+		return converted, true, err
+	case json.Number:
+		if c.dateColumns[field] {
+			converted, err := parseDate(field, v.String())
+			return converted, true, err
+		}
+		converted, err := parseNumber(field, v.String())
+		return converted, true, err
+	case bool:
+		return strconv.FormatBool(v), true, nil
+	default:
+		return v, true, nil
+	}
+}
+
+func parseNumber(column, value string) (interface{}, error) {
+	if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return v, nil
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, errors.Wrapf(models.ErrInvalidTokenMetadata, "attribute %q must be a number: %q", column, value)
+	}
+	return v, nil
+}
+
+func parseDate(column, value string) (interface{}, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+	return nil, errors.Wrapf(models.ErrInvalidTokenMetadata, "attribute %q must be a date: %q", column, value)
+}
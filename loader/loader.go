@@ -0,0 +1,251 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/pkg/errors"
+)
+
+// ErrMissingContractAddress is returned when loading EVM tokens without a contract address
+var ErrMissingContractAddress = errors.New("contract address is required to build EVM tokens")
+
+// ErrUnsupportedArchive is returned when loading a file which is not a supported archive
+var ErrUnsupportedArchive = errors.New("unsupported archive")
+
+// ErrDuplicateToken is reported when two files describe tokens with the same identifier
+var ErrDuplicateToken = errors.New("duplicate token")
+
+// TokenFactory is used to build a token from the name of a metadata file, without its
+// directories, and its content.
+type TokenFactory func(name string, data []byte) (models.IToken, error)
+
+// FileError describes a metadata file which couldn't be read or turned into a token
+type FileError struct {
+	// Path is the path of the file, relative to the loaded directory or archive
+	Path string
+	// Err is the reason why the file couldn't be loaded
+	Err error
+}
+
+// Error is used to describe the file error
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// Unwrap is used to get the reason why the file couldn't be loaded
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// Report describes the outcome of loading the metadata files of a collection
+type Report struct {
+	// Loaded is the number of files turned into tokens
+	Loaded int
+	// Errors holds every file which couldn't be loaded, ordered by path
+	Errors []*FileError
+}
+
+// Loader builds collections from metadata files stored in a directory, a tar archive,
+// which may be gzipped, or a zip archive. Only files with the .json extension or with
+// no extension are loaded, hidden files are ignored.
+type Loader struct {
+	contractAddress   string
+	tokenIDField      string
+	collectionName    string
+	tokenFactory      TokenFactory
+	collectionOptions []models.CollectionOption
+}
+
+// Option is used to configure the optional behaviours of Loader
+type Option func(l *Loader)
+
+// WithContractAddress is used to set the address of the contract of the loaded EVM tokens
+func WithContractAddress(contractAddress string) Option {
+	return func(l *Loader) {
+		l.contractAddress = contractAddress
+	}
+}
+
+// WithTokenIDField is used to read the token ID of EVM tokens from the given top-level
+// field of their metadata, instead of deriving it from their file name.
+func WithTokenIDField(field string) Option {
+	return func(l *Loader) {
+		l.tokenIDField = field
+	}
+}
+
+// WithCollectionName is used to name the loaded collection, which is named after the
+// loaded directory or archive by default.
+func WithCollectionName(name string) Option {
+	return func(l *Loader) {
+		l.collectionName = name
+	}
+}
+
+// WithTokenFactory is used to build tokens with the given factory, instead of building
+// ERC721 tokens from OpenSea metadata.
+func WithTokenFactory(factory TokenFactory) Option {
+	return func(l *Loader) {
+		l.tokenFactory = factory
+	}
+}
+
+// WithCollectionOptions is used to configure the loaded collection
+func WithCollectionOptions(opts ...models.CollectionOption) Option {
+	return func(l *Loader) {
+		l.collectionOptions = append(l.collectionOptions, opts...)
+	}
+}
+
+// NewLoader is the constructor of Loader, it builds ERC721 tokens from OpenSea metadata by default,
+// see WithContractAddress and WithTokenIDField.
+func NewLoader(opts ...Option) *Loader {
+	l := &Loader{}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Load is used to build a collection from the metadata files of a directory, or of a .tar,
+// .tar.gz, .tgz or .zip archive. Files which can't be read or turned into tokens are listed in the
+// report instead of failing the load, an error is only returned if the files can't be listed.
+func Load(root string, opts ...Option) (*models.Collection, *Report, error) {
+	return NewLoader(opts...).Load(root)
+}
+
+// Load is used to build a collection from the metadata files of a directory, or of a .tar,
+// .tar.gz, .tgz or .zip archive. Files which can't be read or turned into tokens are listed in the
+// report instead of failing the load, an error is only returned if the files can't be listed.
+func (l *Loader) Load(root string) (*models.Collection, *Report, error) {
+	tokenFactory := l.tokenFactory
+	if tokenFactory == nil {
+		if l.contractAddress == "" {
+			return nil, nil, ErrMissingContractAddress
+		}
+		tokenFactory = l.newERC721Token
+	}
+	files, err := readFiles(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].path < files[j].path
+	})
+	report := &Report{}
+	tokens := make([]models.IToken, 0, len(files))
+	identifiers := map[string]string{}
+	for _, file := range files {
+		if file.err != nil {
+			report.Errors = append(report.Errors, &FileError{Path: file.path, Err: file.err})
+			continue
+		}
+		token, err := tokenFactory(path.Base(file.path), file.data)
+		if err == nil {
+			identifier := token.TokenIdentifier().String()
+			if duplicatePath, exists := identifiers[identifier]; exists {
+				err = errors.Wrapf(ErrDuplicateToken, "%s already loaded from %s", identifier, duplicatePath)
+			} else {
+				identifiers[identifier] = file.path
+			}
+		}
+		if err != nil {
+			report.Errors = append(report.Errors, &FileError{Path: file.path, Err: err})
+			continue
+		}
+		tokens = append(tokens, token)
+		report.Loaded++
+	}
+	name := l.collectionName
+	if name == "" {
+		name = trimArchiveExt(filepath.Base(root))
+	}
+	collection, err := models.NewCollection(name, tokens, l.collectionOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return collection, report, nil
+}
+
+// newERC721Token is used to build an ERC721 token from OpenSea metadata, the token ID is
+// read from the configured field, or derived from the file name.
+func (l *Loader) newERC721Token(name string, data []byte) (models.IToken, error) {
+	metadata, err := models.ParseOpenSeaMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	tokenID := trimMetadataExt(name)
+	if l.tokenIDField != "" {
+		if tokenID, err = readTokenIDField(data, l.tokenIDField); err != nil {
+			return nil, err
+		}
+	}
+	identifier, err := models.ParseEVMContractTokenIdentifier(l.contractAddress, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := metadata.TokenMetadata()
+	if err != nil {
+		return nil, err
+	}
+	return models.NewToken(identifier, models.TokenStandardERC721, attributes), nil
+}
+
+// MetaplexTokenFactory is a TokenFactory building Metaplex tokens from files named after
+// their mint address, e.g. <mint address>.json.
+func MetaplexTokenFactory(name string, data []byte) (models.IToken, error) {
+	return models.NewMetaplexToken(trimMetadataExt(name), data)
+}
+
+// readTokenIDField is used to read a token ID held by a JSON number or string
+func readTokenIDField(data []byte, field string) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var fields map[string]interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return "", errors.Wrap(models.ErrInvalidTokenMetadata, err.Error())
+	}
+	switch v := fields[field].(type) {
+	case json.Number:
+		return v.String(), nil
+	case string:
+		return v, nil
+	default:
+		return "", errors.Wrapf(models.ErrInvalidTokenID, "field %q holds %v", field, fields[field])
+	}
+}
+
+// isMetadataFile is used to determine whether the file at the given slash-separated path
+// should be loaded
+func isMetadataFile(filePath string) bool {
+	for _, part := range strings.Split(filePath, "/") {
+		if strings.HasPrefix(part, ".") || part == "__MACOSX" {
+			return false
+		}
+	}
+	ext := path.Ext(filePath)
+	return ext == "" || strings.EqualFold(ext, ".json")
+}
+
+func trimMetadataExt(name string) string {
+	if strings.EqualFold(path.Ext(name), ".json") {
+		return name[:len(name)-len(".json")]
+	}
+	return name
+}
+
+func trimArchiveExt(name string) string {
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar", ".zip"} {
+		if strings.HasSuffix(strings.ToLower(name), ext) {
+			return name[:len(name)-len(ext)]
+		}
+	}
+	return name
+}
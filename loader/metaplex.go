@@ -0,0 +1,13 @@
+package loader
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+)
+
+// LoadMetaplexDir is used to build a collection from a directory, or an archive, of Metaplex
+// off-chain JSON documents named after the mint address of their token, e.g. <mint address>.json.
+// It is the same as Load with MetaplexTokenFactory, files which can't be turned into tokens
+// are listed in the report instead of failing the load.
+func LoadMetaplexDir(dir string, opts ...Option) (*models.Collection, *Report, error) {
+	return NewLoader(append([]Option{WithTokenFactory(MetaplexTokenFactory)}, opts...)...).Load(dir)
+}
@@ -0,0 +1,134 @@
+package loader
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// metadataFile is a metadata file read from a directory or an archive
+type metadataFile struct {
+	// path is the slash-separated path of the file, relative to the directory or archive
+	path string
+	data []byte
+	// err is the reason why the file couldn't be read, it is reported instead of failing the load
+	err error
+}
+
+// readFiles is used to read the metadata files of a directory, or of a .tar, .tar.gz,
+// .tgz or .zip archive.
+func readFiles(root string) ([]*metadataFile, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return readDirFiles(root)
+	}
+	name := strings.ToLower(root)
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return readZipFiles(root)
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return readTarFiles(root, true)
+	case strings.HasSuffix(name, ".tar"):
+		return readTarFiles(root, false)
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedArchive,
+			"%s is neither a directory nor a .tar, .tar.gz, .tgz or .zip archive", root)
+	}
+}
+
+func readDirFiles(root string) ([]*metadataFile, error) {
+	var files []*metadataFile
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if !isMetadataFile(relPath) {
+			return nil
+		}
+		data, err := os.ReadFile(filePath)
+		files = append(files, &metadataFile{path: relPath, data: data, err: err})
+		return nil
+	})
+	return files, err
+}
+
+func readZipFiles(root string) ([]*metadataFile, error) {
+	archive, err := zip.OpenReader(root)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+	var files []*metadataFile
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() || !isMetadataFile(entry.Name) {
+			continue
+		}
+		data, err := readZipFile(entry)
+		files = append(files, &metadataFile{path: entry.Name, data: data, err: err})
+	}
+	return files, nil
+}
+
+func readZipFile(entry *zip.File) ([]byte, error) {
+	reader, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+func readTarFiles(root string, gzipped bool) ([]*metadataFile, error) {
+	file, err := os.Open(root)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var reader io.Reader = file
+	if gzipped {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+	archive := tar.NewReader(reader)
+	var files []*metadataFile
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimPrefix(header.Name, "./")
+		if header.Typeflag != tar.TypeReg || !isMetadataFile(name) {
+			continue
+		}
+		data, err := io.ReadAll(archive)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &metadataFile{path: name, data: data})
+	}
+}
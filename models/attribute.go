@@ -4,8 +4,12 @@ import (
 	"strings"
 )
 
+// MetaTraitAttributePrefix defines the name prefix of the meta attributes derived from the
+// other attributes of a token, which are not traits of the token.
+const MetaTraitAttributePrefix = "meta_trait:"
+
 // TraitCountAttributeName defines the trait count attribute name
-const TraitCountAttributeName = "meta_trait:trait_count"
+const TraitCountAttributeName = MetaTraitAttributePrefix + "trait_count"
 
 // IAttribute defines the interface of attribute
 type IAttribute interface {
@@ -0,0 +1,290 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// INumericBinner is used to bucket the numeric attribute values of a collection
+// into categorical values, so that they can be counted and scored the same way
+// as string attributes.
+type INumericBinner interface {
+	// Bin is used to map every value of an attribute across the collection to the
+	// label of its bucket. One label must be returned per value, in the order of values.
+	Bin(values []float64) []StringAttributeValue
+}
+
+// EqualWidthBinner splits the range between the minimum and maximum value of an
+// attribute into a fixed number of buckets of the same width.
+type EqualWidthBinner struct {
+	bins int
+}
+
+var _ INumericBinner = &EqualWidthBinner{}
+
+// NewEqualWidthBinner is the constructor of EqualWidthBinner
+func NewEqualWidthBinner(bins int) *EqualWidthBinner {
+	return &EqualWidthBinner{
+		bins: bins,
+	}
+}
+
+// Bin is used to map every value of an attribute across the collection to the
+// label of its bucket.
+func (c *EqualWidthBinner) Bin(values []float64) []StringAttributeValue {
+	lower, upper := minMaxFloat64(values)
+	if c.bins <= 1 || lower == upper {
+		return binByEdges(values, nil, lower, upper, formatBinBound)
+	}
+	width := (upper - lower) / float64(c.bins)
+	edges := make([]float64, 0, c.bins-1)
+	for i := 1; i < c.bins; i++ {
+		edges = append(edges, lower+float64(i)*width)
+	}
+	return binByEdges(values, edges, lower, upper, formatBinBound)
+}
+
+// QuantileBinner splits the values of an attribute into buckets holding roughly
+// the same number of tokens.
+type QuantileBinner struct {
+	bins int
+}
+
+var _ INumericBinner = &QuantileBinner{}
+
+// NewQuantileBinner is the constructor of QuantileBinner
+func NewQuantileBinner(bins int) *QuantileBinner {
+	return &QuantileBinner{
+		bins: bins,
+	}
+}
+
+// Bin is used to map every value of an attribute across the collection to the
+// label of its bucket.
+func (c *QuantileBinner) Bin(values []float64) []StringAttributeValue {
+	lower, upper := minMaxFloat64(values)
+	if c.bins <= 1 || lower == upper {
+		return binByEdges(values, nil, lower, upper, formatBinBound)
+	}
+	sortedValues := make([]float64, len(values))
+	copy(sortedValues, values)
+	sort.Float64s(sortedValues)
+	edges := make([]float64, 0, c.bins-1)
+	for i := 1; i < c.bins; i++ {
+		edge := sortedValues[i*len(sortedValues)/c.bins]
+		// skip the edges which would produce empty buckets
+		if edge == lower || (len(edges) > 0 && edges[len(edges)-1] == edge) {
+			continue
+		}
+		edges = append(edges, edge)
+	}
+	return binByEdges(values, edges, lower, upper, formatBinBound)
+}
+
+// EdgesBinner buckets the values of an attribute by explicit bucket edges.
+// Values lower than the first edge or not lower than the last edge fall
+// into open-ended buckets.
+type EdgesBinner struct {
+	edges []float64
+}
+
+var _ INumericBinner = &EdgesBinner{}
+
+// NewEdgesBinner is the constructor of EdgesBinner
+func NewEdgesBinner(edges ...float64) *EdgesBinner {
+	sortedEdges := make([]float64, len(edges))
+	copy(sortedEdges, edges)
+	sort.Float64s(sortedEdges)
+	return &EdgesBinner{
+		edges: sortedEdges,
+	}
+}
+
+// Bin is used to map every value of an attribute across the collection to the
+// label of its bucket.
+func (c *EdgesBinner) Bin(values []float64) []StringAttributeValue {
+	return binByEdges(values, c.edges, math.Inf(-1), math.Inf(1), formatBinBound)
+}
+
+// CategoricalBinner treats every distinct numeric value as its own category.
+type CategoricalBinner struct{}
+
+var _ INumericBinner = &CategoricalBinner{}
+
+// NewCategoricalBinner is the constructor of CategoricalBinner
+func NewCategoricalBinner() *CategoricalBinner {
+	return &CategoricalBinner{}
+}
+
+// Bin is used to map every value of an attribute across the collection to the
+// label of its bucket.
+func (c *CategoricalBinner) Bin(values []float64) []StringAttributeValue {
+	labels := make([]StringAttributeValue, 0, len(values))
+	for _, value := range values {
+		labels = append(labels, formatBinBound(value))
+	}
+	return labels
+}
+
+// binByEdges is used to label every value with the bucket delimited by the sorted
+// edges, the lower bound and the upper bound. The last bucket is closed when the
+// upper bound is finite, so that the maximum value belongs to it.
+func binByEdges(
+	values []float64,
+	edges []float64,
+	lower, upper float64,
+	format func(bound float64) string,
+) []StringAttributeValue {
+	bounds := make([]float64, 0, len(edges)+2)
+	bounds = append(bounds, lower)
+	bounds = append(bounds, edges...)
+	bounds = append(bounds, upper)
+	labels := make([]StringAttributeValue, 0, len(values))
+	for _, value := range values {
+		idx := sort.Search(len(edges), func(i int) bool {
+			return edges[i] > value
+		})
+		closing := ")"
+		if idx == len(edges) && !math.IsInf(upper, 1) {
+			closing = "]"
+		}
+		labels = append(labels,
+			"["+format(bounds[idx])+", "+format(bounds[idx+1])+closing,
+		)
+	}
+	return labels
+}
+
+func formatBinBound(value float64) string {
+	switch {
+	case math.IsInf(value, -1):
+		return "-inf"
+	case math.IsInf(value, 1):
+		return "+inf"
+	default:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+}
+
+func minMaxFloat64(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	lower, upper := values[0], values[0]
+	for _, value := range values[1:] {
+		lower = math.Min(lower, value)
+		upper = math.Max(upper, value)
+	}
+	return lower, upper
+}
+
+// IDateBinner is used to bucket the date attribute values of a collection into
+// categorical values, so that they can be counted and scored the same way as
+// string attributes.
+type IDateBinner interface {
+	// Bin is used to map every value of an attribute across the collection to the
+	// label of its bucket. One label must be returned per value, in the order of values.
+	Bin(values []DateAttributeValue) []StringAttributeValue
+}
+
+// DateWindow defines the calendar window used to bucket dates
+type DateWindow string
+
+// defines a set of date windows
+const (
+	DateWindowDay   DateWindow = "day"
+	DateWindowMonth DateWindow = "month"
+	DateWindowYear  DateWindow = "year"
+)
+
+// DateWindowBinner buckets the dates of an attribute by the calendar window they fall into.
+type DateWindowBinner struct {
+	window   DateWindow
+	location *time.Location
+}
+
+var _ IDateBinner = &DateWindowBinner{}
+
+// NewDateWindowBinner is the constructor of DateWindowBinner, dates are bucketed in UTC.
+func NewDateWindowBinner(window DateWindow) *DateWindowBinner {
+	return NewDateWindowBinnerInLocation(window, time.UTC)
+}
+
+// NewDateWindowBinnerInLocation is the constructor of DateWindowBinner, dates are bucketed
+// in the given location, or in UTC if it is nil.
+func NewDateWindowBinnerInLocation(window DateWindow, location *time.Location) *DateWindowBinner {
+	if location == nil {
+		location = time.UTC
+	}
+	return &DateWindowBinner{
+		window:   window,
+		location: location,
+	}
+}
+
+// Bin is used to map every value of an attribute across the collection to the
+// label of its bucket.
+func (c *DateWindowBinner) Bin(values []DateAttributeValue) []StringAttributeValue {
+	layout := "2006-01-02"
+	switch c.window {
+	case DateWindowMonth:
+		layout = "2006-01"
+	case DateWindowYear:
+		layout = "2006"
+	}
+	labels := make([]StringAttributeValue, 0, len(values))
+	for _, value := range values {
+		labels = append(labels, time.Unix(value, 0).In(c.location).Format(layout))
+	}
+	return labels
+}
+
+// DateBoundariesBinner buckets the dates of an attribute by explicit boundaries.
+// Dates before the first boundary or not before the last boundary fall into
+// open-ended buckets.
+type DateBoundariesBinner struct {
+	edges []float64
+}
+
+var _ IDateBinner = &DateBoundariesBinner{}
+
+// NewDateBoundariesBinner is the constructor of DateBoundariesBinner
+func NewDateBoundariesBinner(boundaries ...time.Time) *DateBoundariesBinner {
+	edges := make([]float64, 0, len(boundaries))
+	for _, boundary := range boundaries {
+		edges = append(edges, float64(boundary.Unix()))
+	}
+	sort.Float64s(edges)
+	return &DateBoundariesBinner{
+		edges: edges,
+	}
+}
+
+// Bin is used to map every value of an attribute across the collection to the
+// label of its bucket.
+func (c *DateBoundariesBinner) Bin(values []DateAttributeValue) []StringAttributeValue {
+	floatValues := make([]float64, 0, len(values))
+	for _, value := range values {
+		floatValues = append(floatValues, float64(value))
+	}
+	return binByEdges(floatValues, c.edges, math.Inf(-1), math.Inf(1), formatDateBinBound)
+}
+
+func formatDateBinBound(value float64) string {
+	if math.IsInf(value, 0) {
+		return formatBinBound(value)
+	}
+	return time.Unix(int64(value), 0).UTC().Format(time.RFC3339)
+}
+
+// NumericAttributeValueFloat64 is used to convert the stored numeric value to float64,
+// regardless of whether it holds a floating point or an integer value.
+func NumericAttributeValueFloat64(value INumericAttributeValue) float64 {
+	if v, ok := value.Float64(); ok {
+		return v
+	}
+	v, _ := value.Int64()
+	return float64(v)
+}
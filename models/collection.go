@@ -2,8 +2,13 @@ package models
 
 import (
 	"strconv"
+
+	"github.com/pkg/errors"
 )
 
+// ErrBinnerLabelsMismatch is returned when a binner doesn't return one label per attribute value
+var ErrBinnerLabelsMismatch = errors.New("binner must return one label per value")
+
 // ICollection represents collection of tokens used to determine token rarity score.
 // A token's rarity is influenced by the attribute frequency of all the tokens
 // in a collection.
@@ -23,7 +28,8 @@ type ICollection interface {
 	ExtractCollectionAttributes() map[AttributeName][]*CollectionAttribute
 	// TokenStandards is used to return token standards for this collection.
 	TokenStandards() []TokenStandard
-	// HasNumericAttribute is used to determine whether the current collection contains numeric attributes
+	// HasNumericAttribute is used to determine whether the current collection contains numeric or date
+	// attributes which have not been bucketed into string attributes.
 	HasNumericAttribute() bool
 }
 
@@ -35,7 +41,34 @@ var _ ICollection = &Collection{}
 type Collection struct {
 	name                      string
 	tokens                    []IToken
+	supplies                  []int
 	attributesFrequencyCounts map[AttributeName]map[StringAttributeValue]int
+	numericBinner             INumericBinner
+	attributeNumericBinners   map[AttributeName]INumericBinner
+	dateBinner                IDateBinner
+	attributeDateBinners      map[AttributeName]IDateBinner
+}
+
+// CollectionOption is used to configure the optional behaviours of Collection
+type CollectionOption func(c *Collection)
+
+// WithNumericBinner is used to bucket every numeric attribute of the collection with
+// the given binner, unless a dedicated binner is set by WithAttributeNumericBinner.
+func WithNumericBinner(binner INumericBinner) CollectionOption {
+	return func(c *Collection) {
+		c.numericBinner = binner
+	}
+}
+
+// WithAttributeNumericBinner is used to bucket the numeric attribute with the given name
+// with the given binner.
+func WithAttributeNumericBinner(name string, binner INumericBinner) CollectionOption {
+	return func(c *Collection) {
+		if c.attributeNumericBinners == nil {
+			c.attributeNumericBinners = map[AttributeName]INumericBinner{}
+		}
+		c.attributeNumericBinners[NormalizeAttributeString(name)] = binner
+	}
 }
 
 // CollectionAttribute represents an attribute that at least one token in a Collection has.
@@ -46,23 +79,65 @@ type CollectionAttribute struct {
 	TotalTokens int
 }
 
-// NewCollection is the constructor of Collection
-func NewCollection(name string, tokens []IToken) *Collection {
+// WithDateBinner is used to bucket every date attribute of the collection with
+// the given binner, unless a dedicated binner is set by WithAttributeDateBinner.
+func WithDateBinner(binner IDateBinner) CollectionOption {
+	return func(c *Collection) {
+		c.dateBinner = binner
+	}
+}
+
+// WithAttributeDateBinner is used to bucket the date attribute with the given name
+// with the given binner.
+func WithAttributeDateBinner(name string, binner IDateBinner) CollectionOption {
+	return func(c *Collection) {
+		if c.attributeDateBinners == nil {
+			c.attributeDateBinners = map[AttributeName]IDateBinner{}
+		}
+		c.attributeDateBinners[NormalizeAttributeString(name)] = binner
+	}
+}
+
+// NewCollection is the constructor of Collection, it returns ErrBinnerLabelsMismatch if a binner
+// doesn't return one label per attribute value.
+func NewCollection(name string, tokens []IToken, opts ...CollectionOption) (*Collection, error) {
+	return newCollection(name, tokens, nil, opts...)
+}
+
+// newCollection is used to build a Collection where every token counts as many times
+// as its supply, a nil supplies means every token has a supply of one.
+func newCollection(name string, tokens []IToken, supplies []int, opts ...CollectionOption) (*Collection, error) {
 	c := &Collection{
-		name:   name,
-		tokens: tokens,
+		name:     name,
+		tokens:   tokens,
+		supplies: supplies,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
 	c.traitCountify(tokens)
+	binnedTokens, err := c.binAttributes(tokens)
+	if err != nil {
+		return nil, err
+	}
+	c.tokens = binnedTokens
 	c.attributesFrequencyCounts = c.deriveNormalizedAttrsFrequencyCount()
-	return c
+	return c, nil
 }
 
-// HasNumericAttribute is used to determine whether the current collection contains numeric attributes
+// HasNumericAttribute is used to determine whether the current collection contains numeric or date
+// attributes which have not been bucketed into string attributes.
 func (c *Collection) HasNumericAttribute() bool {
 	for _, token := range c.tokens {
-		if len(token.Metadata().NumericAttributes()) > 0 ||
-			len(token.Metadata().DateAttributes()) > 0 {
-			return true
+		for name := range token.Metadata().NumericAttributes() {
+			if !token.HasAttribute(name) {
+				return true
+			}
+		}
+		for name := range token.Metadata().DateAttributes() {
+			if !token.HasAttribute(name) {
+				return true
+			}
 		}
 	}
 	return false
@@ -75,18 +150,19 @@ func (c *Collection) TotalTokensWithAttributes(attribute IStringAttribute) int {
 }
 
 // deriveNormalizedAttrsFrequencyCount is used to Derive and construct attributes_frequency_counts based on
-// string attributes on tokens. Numeric or date attributes currently not
-// supported.
+// string attributes on tokens. Numeric or date attributes are only counted through the
+// string attributes derived by binAttributes.
 func (c *Collection) deriveNormalizedAttrsFrequencyCount() map[AttributeName]map[StringAttributeValue]int {
 	attrsFreqCounts := map[AttributeName]map[StringAttributeValue]int{}
-	for _, token := range c.tokens {
+	for idx, token := range c.tokens {
+		supply := c.tokenSupply(idx)
 		for attrName, strAttr := range token.Metadata().StringAttributes() {
 			if attrsFreqCounts[attrName] == nil {
 				attrsFreqCounts[attrName] = map[StringAttributeValue]int{
-					strAttr.Value(): 1,
+					strAttr.Value(): supply,
 				}
 			} else {
-				attrsFreqCounts[attrName][strAttr.Value()]++
+				attrsFreqCounts[attrName][strAttr.Value()] += supply
 			}
 		}
 	}
@@ -110,14 +186,155 @@ func (c *Collection) traitCountify(tokens []IToken) {
 	}
 }
 
-// Tokens method is used to get all tokens in this collection
+// binAttributes is used to bucket the numeric and date attributes of the tokens with the binner
+// configured for each attribute. The tokens with a bucketed attribute are replaced by a copy
+// which has a string attribute holding the bucket label, the given tokens are left unchanged.
+func (c *Collection) binAttributes(tokens []IToken) ([]IToken, error) {
+	if c.numericBinner == nil && len(c.attributeNumericBinners) == 0 &&
+		c.dateBinner == nil && len(c.attributeDateBinners) == 0 {
+		return tokens, nil
+	}
+	labels := make([]map[AttributeName]StringAttributeValue, len(tokens))
+	numericValues := groupAttributeValues(tokens, func(token IToken) map[AttributeName]float64 {
+		values := map[AttributeName]float64{}
+		for attrName, numericAttr := range token.Metadata().NumericAttributes() {
+			values[attrName] = NumericAttributeValueFloat64(numericAttr.Value())
+		}
+		return values
+	})
+	err := binAttributeValues(numericValues, labels, func(attrName AttributeName) func([]float64) []StringAttributeValue {
+		binner := c.attributeNumericBinners[attrName]
+		if binner == nil {
+			binner = c.numericBinner
+		}
+		if binner == nil {
+			return nil
+		}
+		return binner.Bin
+	})
+	if err != nil {
+		return nil, err
+	}
+	dateValues := groupAttributeValues(tokens, func(token IToken) map[AttributeName]DateAttributeValue {
+		values := map[AttributeName]DateAttributeValue{}
+		for attrName, dateAttr := range token.Metadata().DateAttributes() {
+			values[attrName] = dateAttr.Value()
+		}
+		return values
+	})
+	err = binAttributeValues(dateValues, labels, func(attrName AttributeName) func([]DateAttributeValue) []StringAttributeValue {
+		binner := c.attributeDateBinners[attrName]
+		if binner == nil {
+			binner = c.dateBinner
+		}
+		if binner == nil {
+			return nil
+		}
+		return binner.Bin
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	binnedTokens := make([]IToken, len(tokens))
+	for idx, token := range tokens {
+		if len(labels[idx]) == 0 {
+			binnedTokens[idx] = token
+			continue
+		}
+		metadata := copyTokenMetadata(token.Metadata())
+		for attrName, label := range labels[idx] {
+			metadata.AddAttribute(NewStringAttribute(attrName, label))
+		}
+		binnedTokens[idx] = NewToken(token.TokenIdentifier(), token.TokenStandard(), metadata)
+	}
+	return binnedTokens, nil
+}
+
+// attributeValues holds the values of an attribute, with the index of the token of each value
+type attributeValues[V any] struct {
+	tokens []int
+	values []V
+}
+
+// groupAttributeValues is used to group the attribute values of the tokens by attribute name
+func groupAttributeValues[V any](
+	tokens []IToken,
+	tokenValues func(token IToken) map[AttributeName]V,
+) map[AttributeName]*attributeValues[V] {
+	groups := map[AttributeName]*attributeValues[V]{}
+	for idx, token := range tokens {
+		for attrName, value := range tokenValues(token) {
+			group := groups[attrName]
+			if group == nil {
+				group = &attributeValues[V]{}
+				groups[attrName] = group
+			}
+			group.tokens = append(group.tokens, idx)
+			group.values = append(group.values, value)
+		}
+	}
+	return groups
+}
+
+// binAttributeValues is used to bucket every group of attribute values with the bin function of its
+// attribute, and to record the bucket labels by token index. binner returns nil for attributes which
+// are not bucketed.
+func binAttributeValues[V any](
+	groups map[AttributeName]*attributeValues[V],
+	labels []map[AttributeName]StringAttributeValue,
+	binner func(attrName AttributeName) func(values []V) []StringAttributeValue,
+) error {
+	for attrName, group := range groups {
+		bin := binner(attrName)
+		if bin == nil {
+			continue
+		}
+		binLabels := bin(group.values)
+		if len(binLabels) != len(group.values) {
+			return errors.Wrapf(ErrBinnerLabelsMismatch, "attribute %q has %d values, got %d labels",
+				attrName, len(group.values), len(binLabels))
+		}
+		for idx, label := range binLabels {
+			tokenIdx := group.tokens[idx]
+			if labels[tokenIdx] == nil {
+				labels[tokenIdx] = map[AttributeName]StringAttributeValue{}
+			}
+			labels[tokenIdx][attrName] = label
+		}
+	}
+	return nil
+}
+
+// Name is used to get the name of this collection
+func (c *Collection) Name() string {
+	return c.name
+}
+
+// Tokens method is used to get all tokens in this collection. Tokens with numeric or date
+// attributes bucketed by a binner are copies of the tokens given to NewCollection.
 func (c *Collection) Tokens() []IToken {
 	return c.tokens
 }
 
 // TokenTotalSupply is used get the total supply of this collection
 func (c *Collection) TokenTotalSupply() int {
-	return len(c.tokens)
+	if c.supplies == nil {
+		return len(c.tokens)
+	}
+	var totalSupply int
+	for _, supply := range c.supplies {
+		totalSupply += supply
+	}
+	return totalSupply
+}
+
+// tokenSupply is used to get the supply of the token at the given index
+func (c *Collection) tokenSupply(idx int) int {
+	if c.supplies == nil {
+		return 1
+	}
+	return c.supplies[idx]
 }
 
 // TotalAttributeValues is used to get the number of values of specified attributeName
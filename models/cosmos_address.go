@@ -0,0 +1,75 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidBech32Address is returned when a Cosmos address is malformed or has an invalid bech32 checksum
+var ErrInvalidBech32Address = errors.New("invalid bech32 address")
+
+// bech32Charset is the alphabet of the data part of bech32 addresses
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// NormalizeBech32Address is used to validate a bech32 address, such as the address of a Cosmos
+// contract, and convert it to its canonical lower-case form. The address must be a human-readable
+// prefix followed by 1 and at least six data characters, the last six of which are the checksum,
+// see https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki
+func NormalizeBech32Address(address string) (string, error) {
+	lowerAddress := strings.ToLower(address)
+	if address != lowerAddress && address != strings.ToUpper(address) {
+		return "", errors.Wrapf(ErrInvalidBech32Address, "%q mixes lower and upper case characters", address)
+	}
+	separator := strings.LastIndex(lowerAddress, "1")
+	if separator < 1 || separator+7 > len(lowerAddress) {
+		return "", errors.Wrapf(ErrInvalidBech32Address, "%q must be a prefix followed by 1 and its data", address)
+	}
+	hrp := lowerAddress[:separator]
+	for _, c := range hrp {
+		if c < 33 || c > 126 {
+			return "", errors.Wrapf(ErrInvalidBech32Address, "%q has an invalid prefix", address)
+		}
+	}
+	data := make([]byte, 0, len(lowerAddress)-separator-1)
+	for _, c := range lowerAddress[separator+1:] {
+		idx := strings.IndexRune(bech32Charset, c)
+		if idx < 0 {
+			return "", errors.Wrapf(ErrInvalidBech32Address, "%q has an invalid data character %q", address, c)
+		}
+		data = append(data, byte(idx))
+	}
+	if bech32Polymod(append(bech32ExpandHRP(hrp), data...)) != 1 {
+		return "", errors.Wrapf(ErrInvalidBech32Address, "%q doesn't match its checksum", address)
+	}
+	return lowerAddress, nil
+}
+
+// bech32ExpandHRP is used to expand the human-readable prefix for the checksum computation
+func bech32ExpandHRP(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// bech32Polymod is the BCH checksum of bech32, a valid address has a checksum of one
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+	return checksum
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CosmosCW721TokenIdentifier indicates that this token is identified by the bech32 address of its
+// CW721 contract and its token ID. CW721 token IDs are arbitrary strings.
+type CosmosCW721TokenIdentifier struct {
+	contractAddress string
+	tokenID         string
+}
+
+var _ ITokenIdentifier = &CosmosCW721TokenIdentifier{}
+
+// NewCosmosCW721TokenIdentifier is the constructor of CosmosCW721TokenIdentifier. It fails if the
+// contract address is malformed, see NormalizeBech32Address, or if the token ID is empty.
+func NewCosmosCW721TokenIdentifier(contractAddress string, tokenID string) (CosmosCW721TokenIdentifier, error) {
+	normalizedAddress, err := NormalizeBech32Address(contractAddress)
+	if err != nil {
+		return CosmosCW721TokenIdentifier{}, err
+	}
+	if tokenID == "" {
+		return CosmosCW721TokenIdentifier{}, errors.Wrap(ErrInvalidTokenIdentifier, "empty CW721 token ID")
+	}
+	return CosmosCW721TokenIdentifier{
+		contractAddress: normalizedAddress,
+		tokenID:         tokenID,
+	}, nil
+}
+
+// IdentifierType is used to obtain the identifier type of the current Token.
+func (c CosmosCW721TokenIdentifier) IdentifierType() IdentifierType {
+	return IdentifierTypeCosmosCW721
+}
+
+// ContractAddress is used to get the lower-case bech32 address of the contract the token belongs to
+func (c CosmosCW721TokenIdentifier) ContractAddress() string {
+	return c.contractAddress
+}
+
+// TokenID is used to get the ID of the token in its contract
+func (c CosmosCW721TokenIdentifier) TokenID() string {
+	return c.tokenID
+}
+
+// String is used to get the canonical form of the identifier, e.g. cosmos_cw721:stars1abc:123.
+func (c CosmosCW721TokenIdentifier) String() string {
+	return string(c.IdentifierType()) + ":" + c.contractAddress + ":" + c.tokenID
+}
+
+// Equal is used to determine whether the identifier identifies the same token as other.
+func (c CosmosCW721TokenIdentifier) Equal(other ITokenIdentifier) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare is used to compare the identifier with other, identifiers of the same type
+// are ordered by contract address, then by token ID. Decimal token IDs are ordered by
+// their numeric value, and before any other token ID.
+func (c CosmosCW721TokenIdentifier) Compare(other ITokenIdentifier) int {
+	o, ok := dereferenceTokenIdentifier(other).(CosmosCW721TokenIdentifier)
+	if !ok {
+		return compareTokenIdentifierStrings(c, other)
+	}
+	if cmp := strings.Compare(c.contractAddress, o.contractAddress); cmp != 0 {
+		return cmp
+	}
+	a, aIsNumber := parseDecimalTokenID(c.tokenID)
+	b, bIsNumber := parseDecimalTokenID(o.tokenID)
+	switch {
+	case aIsNumber && bIsNumber:
+		if cmp := a.Cmp(b); cmp != 0 {
+			return cmp
+		}
+	case aIsNumber:
+		return -1
+	case bIsNumber:
+		return 1
+	}
+	return strings.Compare(c.tokenID, o.tokenID)
+}
+
+// parseDecimalTokenID is used to parse a token ID made of decimal digits only
+func parseDecimalTokenID(tokenID string) (*big.Int, bool) {
+	for _, c := range tokenID {
+		if c < '0' || c > '9' {
+			return nil, false
+		}
+	}
+	return new(big.Int).SetString(tokenID, 10)
+}
+
+// cosmosCW721TokenIdentifierJSON is the JSON form of CosmosCW721TokenIdentifier
+type cosmosCW721TokenIdentifierJSON struct {
+	IdentifierType  IdentifierType `json:"identifier_type"`
+	ContractAddress string         `json:"contract_address"`
+	TokenID         string         `json:"token_id"`
+}
+
+// MarshalJSON is used to encode the identifier as a JSON object holding its identifier type
+func (c CosmosCW721TokenIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cosmosCW721TokenIdentifierJSON{
+		IdentifierType:  c.IdentifierType(),
+		ContractAddress: c.contractAddress,
+		TokenID:         c.tokenID,
+	})
+}
+
+// UnmarshalJSON is used to decode the identifier from the JSON object produced by MarshalJSON
+func (c *CosmosCW721TokenIdentifier) UnmarshalJSON(data []byte) error {
+	var v cosmosCW721TokenIdentifierJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.IdentifierType != IdentifierTypeCosmosCW721 {
+		return errors.Wrapf(ErrInvalidTokenIdentifier, "unexpected identifier type %q", v.IdentifierType)
+	}
+	identifier, err := NewCosmosCW721TokenIdentifier(v.ContractAddress, v.TokenID)
+	if err != nil {
+		return err
+	}
+	*c = identifier
+	return nil
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"github.com/pkg/errors"
+)
+
+// IERC1155Collection represents collection of semi-fungible tokens, where every token
+// is minted in a number of editions. Attribute frequencies are weighted by the supply
+// of each token instead of counting every token once.
+type IERC1155Collection interface {
+	ICollection
+	// TokenSupplies is used to get the supply of every token, in the same order as Tokens
+	TokenSupplies() []int
+}
+
+var _ IERC1155Collection = &ERC1155Collection{}
+
+// ERC1155Collection represents collection of semi-fungible tokens, where every token
+// is minted in a number of editions. Attribute frequencies are weighted by the supply
+// of each token instead of counting every token once.
+type ERC1155Collection struct {
+	*Collection
+}
+
+// NewERC1155Collection is the constructor of ERC1155Collection, supplies holds the
+// edition supply of every token in tokens.
+func NewERC1155Collection(
+	name string,
+	tokens []IToken,
+	supplies []int,
+	opts ...CollectionOption,
+) (*ERC1155Collection, error) {
+	if len(tokens) != len(supplies) {
+		return nil, errors.Errorf("number of supplies %d doesn't match number of tokens %d",
+			len(supplies), len(tokens))
+	}
+	for idx, supply := range supplies {
+		if supply < 1 {
+			return nil, errors.Errorf("supply of token at index %d must be positive, got %d", idx, supply)
+		}
+	}
+	tokenSupplies := make([]int, len(supplies))
+	copy(tokenSupplies, supplies)
+	collection, err := newCollection(name, tokens, tokenSupplies, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155Collection{
+		Collection: collection,
+	}, nil
+}
+
+// TokenSupplies is used to get a copy of the supply of every token, in the same order as Tokens
+func (c *ERC1155Collection) TokenSupplies() []int {
+	supplies := make([]int, len(c.supplies))
+	copy(supplies, c.supplies)
+	return supplies
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+// ErrInvalidEVMAddress is returned when an EVM address is malformed or has an invalid EIP-55 checksum
+var ErrInvalidEVMAddress = errors.New("invalid EVM address")
+
+// NormalizeEVMAddress is used to validate an EVM address and convert it to its canonical
+// lower-case form. The address must be 0x followed by 40 hexadecimal characters, and
+// mixed-case addresses must match their EIP-55 checksum, see https://eips.ethereum.org/EIPS/eip-55
+func NormalizeEVMAddress(address string) (string, error) {
+	if len(address) != 42 || (!strings.HasPrefix(address, "0x") && !strings.HasPrefix(address, "0X")) {
+		return "", errors.Wrapf(ErrInvalidEVMAddress, "%q must be 0x followed by 40 hexadecimal characters", address)
+	}
+	digits := address[2:]
+	if _, err := hex.DecodeString(digits); err != nil {
+		return "", errors.Wrapf(ErrInvalidEVMAddress, "%q must be 0x followed by 40 hexadecimal characters", address)
+	}
+	lowerDigits := strings.ToLower(digits)
+	// single-case addresses carry no checksum
+	if digits != lowerDigits && digits != strings.ToUpper(digits) && digits != checksumHexDigits(lowerDigits) {
+		return "", errors.Wrapf(ErrInvalidEVMAddress, "%q doesn't match its EIP-55 checksum", address)
+	}
+	return "0x" + lowerDigits, nil
+}
+
+// ChecksumEVMAddress is used to validate an EVM address and convert it to its EIP-55
+// mixed-case checksum form.
+func ChecksumEVMAddress(address string) (string, error) {
+	normalized, err := NormalizeEVMAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + checksumHexDigits(normalized[2:]), nil
+}
+
+// checksumHexDigits is used to apply the EIP-55 capitalization to the 40 lower-case
+// hexadecimal digits of an address.
+func checksumHexDigits(lowerDigits string) string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lowerDigits))
+	hashDigits := hex.EncodeToString(hash.Sum(nil))
+	checksummed := []byte(lowerDigits)
+	for i, c := range checksummed {
+		if c >= 'a' && c <= 'f' && hashDigits[i] >= '8' {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return string(checksummed)
+}
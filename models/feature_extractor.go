@@ -0,0 +1,189 @@
+package models
+
+import (
+	"strconv"
+	"sync"
+)
+
+// defines the names of the built-in features
+const (
+	UniqueAttributeCountFeatureName    = "unique_attribute_count"
+	MinAttributeProbabilityFeatureName = "min_attribute_probability"
+	TraitCountFeatureName              = "trait_count"
+	OneOfOneFeatureName                = "one_of_one"
+)
+
+// DefaultRareAttributeMaxTokens is the number of tokens at most holding an attribute for the
+// registered rare attribute count extractor.
+const DefaultRareAttributeMaxTokens = 5
+
+// IFeatureExtractor is used to extract a named ranking feature from tokens
+type IFeatureExtractor interface {
+	// Name is used to get the name of the extracted feature
+	Name() string
+	// Extract is used to compute the value of the feature for the token
+	Extract(token IToken, collection ICollection) float64
+}
+
+var (
+	featureExtractorsMu sync.RWMutex
+	featureExtractors   []IFeatureExtractor
+)
+
+func init() {
+	RegisterFeatureExtractor(NewUniqueAttributeCountExtractor())
+	RegisterFeatureExtractor(NewRareAttributeCountExtractor(DefaultRareAttributeMaxTokens))
+	RegisterFeatureExtractor(NewMinAttributeProbabilityExtractor())
+	RegisterFeatureExtractor(NewTraitCountExtractor())
+	RegisterFeatureExtractor(NewOneOfOneExtractor())
+}
+
+// RegisterFeatureExtractor makes a feature extractor available to the ranking of collections.
+// It panics if an extractor with the same name is already registered.
+func RegisterFeatureExtractor(extractor IFeatureExtractor) {
+	featureExtractorsMu.Lock()
+	defer featureExtractorsMu.Unlock()
+	for _, registered := range featureExtractors {
+		if registered.Name() == extractor.Name() {
+			panic("models: RegisterFeatureExtractor called twice for feature " + extractor.Name())
+		}
+	}
+	featureExtractors = append(featureExtractors, extractor)
+}
+
+// FeatureExtractors is used to get all registered feature extractors, in registration order
+func FeatureExtractors() []IFeatureExtractor {
+	featureExtractorsMu.RLock()
+	defer featureExtractorsMu.RUnlock()
+	extractors := make([]IFeatureExtractor, len(featureExtractors))
+	copy(extractors, featureExtractors)
+	return extractors
+}
+
+// UniqueAttributeCountExtractor counts the attributes of a token which no other token holds
+type UniqueAttributeCountExtractor struct{}
+
+var _ IFeatureExtractor = &UniqueAttributeCountExtractor{}
+
+// NewUniqueAttributeCountExtractor is the constructor of UniqueAttributeCountExtractor
+func NewUniqueAttributeCountExtractor() *UniqueAttributeCountExtractor {
+	return &UniqueAttributeCountExtractor{}
+}
+
+// Name is used to get the name of the extracted feature
+func (c *UniqueAttributeCountExtractor) Name() string {
+	return UniqueAttributeCountFeatureName
+}
+
+// Extract is used to compute the value of the feature for the token
+func (c *UniqueAttributeCountExtractor) Extract(token IToken, collection ICollection) float64 {
+	return float64(countAttributesWithMaxTokens(token, collection, 1))
+}
+
+// RareAttributeCountExtractor counts the attributes of a token which are held by at most
+// a given number of tokens.
+type RareAttributeCountExtractor struct {
+	maxTokens int
+}
+
+var _ IFeatureExtractor = &RareAttributeCountExtractor{}
+
+// NewRareAttributeCountExtractor is the constructor of RareAttributeCountExtractor
+func NewRareAttributeCountExtractor(maxTokens int) *RareAttributeCountExtractor {
+	return &RareAttributeCountExtractor{
+		maxTokens: maxTokens,
+	}
+}
+
+// Name is used to get the name of the extracted feature, e.g. rare_attribute_count_5
+func (c *RareAttributeCountExtractor) Name() string {
+	return "rare_attribute_count_" + strconv.Itoa(c.maxTokens)
+}
+
+// Extract is used to compute the value of the feature for the token
+func (c *RareAttributeCountExtractor) Extract(token IToken, collection ICollection) float64 {
+	return float64(countAttributesWithMaxTokens(token, collection, c.maxTokens))
+}
+
+// MinAttributeProbabilityExtractor extracts the probability of the rarest attribute of a token
+type MinAttributeProbabilityExtractor struct{}
+
+var _ IFeatureExtractor = &MinAttributeProbabilityExtractor{}
+
+// NewMinAttributeProbabilityExtractor is the constructor of MinAttributeProbabilityExtractor
+func NewMinAttributeProbabilityExtractor() *MinAttributeProbabilityExtractor {
+	return &MinAttributeProbabilityExtractor{}
+}
+
+// Name is used to get the name of the extracted feature
+func (c *MinAttributeProbabilityExtractor) Name() string {
+	return MinAttributeProbabilityFeatureName
+}
+
+// Extract is used to compute the value of the feature for the token
+func (c *MinAttributeProbabilityExtractor) Extract(token IToken, collection ICollection) float64 {
+	minProbability := float64(1)
+	totalSupply := collection.TokenTotalSupply()
+	if totalSupply == 0 {
+		return minProbability
+	}
+	for _, stringAttribute := range token.Metadata().StringAttributes() {
+		probability := float64(collection.TotalTokensWithAttributes(stringAttribute)) / float64(totalSupply)
+		if probability < minProbability {
+			minProbability = probability
+		}
+	}
+	return minProbability
+}
+
+// TraitCountExtractor extracts the count of non-null traits of a token, not counting the
+// meta trait holding the trait count.
+type TraitCountExtractor struct{}
+
+var _ IFeatureExtractor = &TraitCountExtractor{}
+
+// NewTraitCountExtractor is the constructor of TraitCountExtractor
+func NewTraitCountExtractor() *TraitCountExtractor {
+	return &TraitCountExtractor{}
+}
+
+// Name is used to get the name of the extracted feature
+func (c *TraitCountExtractor) Name() string {
+	return TraitCountFeatureName
+}
+
+// Extract is used to compute the value of the feature for the token
+func (c *TraitCountExtractor) Extract(token IToken, _ ICollection) float64 {
+	traitCount := token.TraitCount()
+	if token.HasAttribute(TraitCountAttributeName) {
+		traitCount--
+	}
+	return float64(traitCount)
+}
+
+// OneOfOneExtractor extracts whether a token is marked as a "1/1", it is 1 when any string
+// attribute of the token holds a one of one marker and 0 otherwise.
+type OneOfOneExtractor struct{}
+
+var _ IFeatureExtractor = &OneOfOneExtractor{}
+
+// NewOneOfOneExtractor is the constructor of OneOfOneExtractor
+func NewOneOfOneExtractor() *OneOfOneExtractor {
+	return &OneOfOneExtractor{}
+}
+
+// Name is used to get the name of the extracted feature
+func (c *OneOfOneExtractor) Name() string {
+	return OneOfOneFeatureName
+}
+
+// Extract is used to compute the value of the feature for the token
+func (c *OneOfOneExtractor) Extract(token IToken, _ ICollection) float64 {
+	for _, stringAttribute := range token.Metadata().StringAttributes() {
+		switch NormalizeAttributeString(stringAttribute.Value()) {
+		case "1/1", "1 of 1", "one of one":
+			return 1
+		}
+	}
+	return 0
+}
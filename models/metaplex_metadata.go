@@ -0,0 +1,121 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MetaplexMetadata represent the off-chain JSON document of a Metaplex token, see
+// https://docs.metaplex.com/programs/token-metadata/token-standard#the-non-fungible-standard
+type MetaplexMetadata struct {
+	Name                 string              `json:"name,omitempty"`
+	Symbol               string              `json:"symbol,omitempty"`
+	Description          string              `json:"description,omitempty"`
+	SellerFeeBasisPoints int                 `json:"seller_fee_basis_points,omitempty"`
+	Image                string              `json:"image,omitempty"`
+	AnimationURL         string              `json:"animation_url,omitempty"`
+	ExternalURL          string              `json:"external_url,omitempty"`
+	Attributes           []OpenSeaAttribute  `json:"attributes"`
+	Properties           *MetaplexProperties `json:"properties,omitempty"`
+	Collection           *MetaplexCollection `json:"collection,omitempty"`
+}
+
+// MetaplexProperties represent the properties of a Metaplex JSON document
+type MetaplexProperties struct {
+	Category string            `json:"category,omitempty"`
+	Files    []MetaplexFile    `json:"files,omitempty"`
+	Creators []MetaplexCreator `json:"creators,omitempty"`
+}
+
+// MetaplexFile represent a file of the properties of a Metaplex JSON document
+type MetaplexFile struct {
+	URI  string `json:"uri"`
+	Type string `json:"type,omitempty"`
+	CDN  bool   `json:"cdn,omitempty"`
+}
+
+// MetaplexCreator represent a creator of the properties of a Metaplex JSON document
+type MetaplexCreator struct {
+	Address string `json:"address"`
+	Share   int    `json:"share"`
+}
+
+// MetaplexCollection represent the collection a Metaplex token belongs to
+type MetaplexCollection struct {
+	Name   string `json:"name,omitempty"`
+	Family string `json:"family,omitempty"`
+}
+
+// ParseMetaplexMetadata is used to decode the off-chain JSON document of a Metaplex token, numbers
+// are kept as json.Number so that integer values are not converted to float64.
+func ParseMetaplexMetadata(data []byte) (*MetaplexMetadata, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var metadata MetaplexMetadata
+	if err := decoder.Decode(&metadata); err != nil {
+		return nil, errors.Wrap(ErrInvalidTokenMetadata, err.Error())
+	}
+	return &metadata, nil
+}
+
+// TokenMetadata is used to create TokenMetadata from the attributes of the document,
+// see NewTokenMetadataFromOpenSeaAttributes for how attributes are converted.
+func (m *MetaplexMetadata) TokenMetadata() (*TokenMetadata, error) {
+	return NewTokenMetadataFromOpenSeaAttributes(m.Attributes)
+}
+
+// CollectionName is used to get the name of the collection the token belongs to, or
+// its family if the collection has no name.
+func (m *MetaplexMetadata) CollectionName() string {
+	if m.Collection == nil {
+		return ""
+	}
+	if m.Collection.Name != "" {
+		return m.Collection.Name
+	}
+	return m.Collection.Family
+}
+
+// NewMetaplexToken Creates a Token class representing a Metaplex non-fungible token given
+// its mint address and its off-chain JSON document. It fails if the mint address is not
+// a base58 encoded Solana address.
+func NewMetaplexToken(mintAddress string, data []byte) (*Token, error) {
+	metadata, err := ParseMetaplexMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewMetaplexTokenFromMetadata(mintAddress, metadata)
+}
+
+// NewMetaplexTokenFromMetadata Creates a Token class representing a Metaplex non-fungible token
+// given its mint address and its decoded off-chain JSON document.
+func NewMetaplexTokenFromMetadata(mintAddress string, metadata *MetaplexMetadata) (*Token, error) {
+	if !isSolanaAddress(mintAddress) {
+		return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q is not a Solana address", mintAddress)
+	}
+	attributes, err := metadata.TokenMetadata()
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		tokenIdentifier: NewSolanaMintAddressTokenIdentifier(mintAddress),
+		tokenStandard:   TokenStandardMetaplexNonFungible,
+		metadata:        attributes,
+	}, nil
+}
+
+// isSolanaAddress is used to check whether the address is a base58 encoded 32 bytes public key
+func isSolanaAddress(address string) bool {
+	if len(address) < 32 || len(address) > 44 {
+		return false
+	}
+	for _, c := range address {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MoveObjectTokenIdentifier indicates that this token is identified by the address of its object on
+// a Move based chain, Aptos or Sui, where every non-fungible token is an object of its own.
+type MoveObjectTokenIdentifier struct {
+	identifierType IdentifierType
+	objectID       string
+}
+
+var _ ITokenIdentifier = &MoveObjectTokenIdentifier{}
+
+// NewAptosObjectTokenIdentifier is the constructor of MoveObjectTokenIdentifier for Aptos objects,
+// see NormalizeMoveObjectID for the accepted object IDs.
+func NewAptosObjectTokenIdentifier(objectID string) (MoveObjectTokenIdentifier, error) {
+	return newMoveObjectTokenIdentifier(IdentifierTypeAptosObject, objectID)
+}
+
+// NewSuiObjectTokenIdentifier is the constructor of MoveObjectTokenIdentifier for Sui objects,
+// see NormalizeMoveObjectID for the accepted object IDs.
+func NewSuiObjectTokenIdentifier(objectID string) (MoveObjectTokenIdentifier, error) {
+	return newMoveObjectTokenIdentifier(IdentifierTypeSuiObject, objectID)
+}
+
+func newMoveObjectTokenIdentifier(identifierType IdentifierType, objectID string) (MoveObjectTokenIdentifier, error) {
+	normalizedID, err := NormalizeMoveObjectID(objectID)
+	if err != nil {
+		return MoveObjectTokenIdentifier{}, err
+	}
+	return MoveObjectTokenIdentifier{
+		identifierType: identifierType,
+		objectID:       normalizedID,
+	}, nil
+}
+
+// NormalizeMoveObjectID is used to validate the ID of an Aptos or Sui object and convert it to its
+// canonical form. The ID must be 0x followed by 1 to 64 hexadecimal characters, the canonical form is
+// lower-case and left-padded with zeros to 64 characters, so that short and long forms are equal.
+func NormalizeMoveObjectID(objectID string) (string, error) {
+	if !strings.HasPrefix(objectID, "0x") || len(objectID) <= 2 || len(objectID) > 66 {
+		return "", errors.Wrapf(
+			ErrInvalidTokenIdentifier, "%q must be 0x followed by up to 64 hexadecimal characters", objectID,
+		)
+	}
+	digits := strings.Repeat("0", 66-len(objectID)) + strings.ToLower(objectID[2:])
+	if _, err := hex.DecodeString(digits); err != nil {
+		return "", errors.Wrapf(
+			ErrInvalidTokenIdentifier, "%q must be 0x followed by up to 64 hexadecimal characters", objectID,
+		)
+	}
+	return "0x" + digits, nil
+}
+
+// IdentifierType is used to obtain the identifier type of the current Token.
+func (c MoveObjectTokenIdentifier) IdentifierType() IdentifierType {
+	return c.identifierType
+}
+
+// ObjectID is used to get the canonical ID of the object of the token
+func (c MoveObjectTokenIdentifier) ObjectID() string {
+	return c.objectID
+}
+
+// String is used to get the canonical form of the identifier, e.g. sui_object:0x00ab.
+func (c MoveObjectTokenIdentifier) String() string {
+	return string(c.IdentifierType()) + ":" + c.objectID
+}
+
+// Equal is used to determine whether the identifier identifies the same token as other.
+func (c MoveObjectTokenIdentifier) Equal(other ITokenIdentifier) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare is used to compare the identifier with other, identifiers of the same type
+// are ordered by object ID.
+func (c MoveObjectTokenIdentifier) Compare(other ITokenIdentifier) int {
+	o, ok := dereferenceTokenIdentifier(other).(MoveObjectTokenIdentifier)
+	if !ok || c.identifierType != o.identifierType {
+		return compareTokenIdentifierStrings(c, other)
+	}
+	return strings.Compare(c.objectID, o.objectID)
+}
+
+// moveObjectTokenIdentifierJSON is the JSON form of MoveObjectTokenIdentifier
+type moveObjectTokenIdentifierJSON struct {
+	IdentifierType IdentifierType `json:"identifier_type"`
+	ObjectID       string         `json:"object_id"`
+}
+
+// MarshalJSON is used to encode the identifier as a JSON object holding its identifier type
+func (c MoveObjectTokenIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moveObjectTokenIdentifierJSON{
+		IdentifierType: c.IdentifierType(),
+		ObjectID:       c.objectID,
+	})
+}
+
+// UnmarshalJSON is used to decode the identifier from the JSON object produced by MarshalJSON
+func (c *MoveObjectTokenIdentifier) UnmarshalJSON(data []byte) error {
+	var v moveObjectTokenIdentifierJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.IdentifierType != IdentifierTypeAptosObject && v.IdentifierType != IdentifierTypeSuiObject {
+		return errors.Wrapf(ErrInvalidTokenIdentifier, "unexpected identifier type %q", v.IdentifierType)
+	}
+	identifier, err := newMoveObjectTokenIdentifier(v.IdentifierType, v.ObjectID)
+	if err != nil {
+		return err
+	}
+	*c = identifier
+	return nil
+}
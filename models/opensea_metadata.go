@@ -0,0 +1,208 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidTokenMetadata is returned when a token metadata document can't be parsed
+var ErrInvalidTokenMetadata = errors.New("invalid token metadata")
+
+// UntypedTraitType is the attribute name given to the traits of a metadata document
+// which have no trait type.
+const UntypedTraitType = "untyped"
+
+// defines the display types of OpenSea attributes, see https://docs.opensea.io/docs/metadata-standards
+const (
+	DisplayTypeNumber          = "number"
+	DisplayTypeBoostNumber     = "boost_number"
+	DisplayTypeBoostPercentage = "boost_percentage"
+	DisplayTypeDate            = "date"
+)
+
+// OpenSeaMetadata represent the ERC721 metadata JSON document of a token, with the
+// attributes array defined by OpenSea, see https://docs.opensea.io/docs/metadata-standards
+type OpenSeaMetadata struct {
+	Name         string             `json:"name,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Image        string             `json:"image,omitempty"`
+	ExternalURL  string             `json:"external_url,omitempty"`
+	AnimationURL string             `json:"animation_url,omitempty"`
+	Attributes   []OpenSeaAttribute `json:"attributes"`
+}
+
+// OpenSeaAttribute represent an item of the attributes array of a metadata document
+type OpenSeaAttribute struct {
+	TraitType   string      `json:"trait_type,omitempty"`
+	Value       interface{} `json:"value"`
+	DisplayType string      `json:"display_type,omitempty"`
+}
+
+// ParseOpenSeaMetadata is used to decode an ERC721 metadata JSON document, numbers are
+// kept as json.Number so that integer values are not converted to float64.
+func ParseOpenSeaMetadata(data []byte) (*OpenSeaMetadata, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var metadata OpenSeaMetadata
+	if err := decoder.Decode(&metadata); err != nil {
+		return nil, errors.Wrap(ErrInvalidTokenMetadata, err.Error())
+	}
+	return &metadata, nil
+}
+
+// NewTokenMetadataFromOpenSeaJSON is used to create TokenMetadata from an ERC721 metadata JSON
+// document, see NewTokenMetadataFromOpenSeaAttributes for how attributes are converted.
+func NewTokenMetadataFromOpenSeaJSON(data []byte) (*TokenMetadata, error) {
+	metadata, err := ParseOpenSeaMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.TokenMetadata()
+}
+
+// TokenMetadata is used to create TokenMetadata from the attributes of the document
+func (m *OpenSeaMetadata) TokenMetadata() (*TokenMetadata, error) {
+	return NewTokenMetadataFromOpenSeaAttributes(m.Attributes)
+}
+
+// NewTokenMetadataFromOpenSeaAttributes is used to create TokenMetadata from an OpenSea attributes array.
+// Values with the display type "number", "boost_number" or "boost_percentage" become numeric attributes,
+// values with the display type "date" become date attributes, from a unix timestamp in seconds or a
+// RFC3339 string, and other values become string attributes, except untyped numbers which stay numeric.
+// Traits without trait type are named UntypedTraitType and null values are skipped.
+// Traits sharing the same normalized trait type are merged into a single string attribute holding
+// their distinct values, sorted and joined by ", ", so that the result doesn't depend on their order.
+func NewTokenMetadataFromOpenSeaAttributes(attributes []OpenSeaAttribute) (*TokenMetadata, error) {
+	attrsValues := map[AttributeName][]interface{}{}
+	for _, attribute := range attributes {
+		if attribute.Value == nil {
+			continue
+		}
+		name := NormalizeAttributeString(attribute.TraitType)
+		if name == "" {
+			name = UntypedTraitType
+		}
+		value, err := convertOpenSeaAttributeValue(name, attribute)
+		if err != nil {
+			return nil, err
+		}
+		attrsValues[name] = append(attrsValues[name], value)
+	}
+	flatAttributes := make(map[string]interface{}, len(attrsValues))
+	for name, values := range attrsValues {
+		flatAttributes[name] = mergeAttributeValues(values)
+	}
+	return NewTokenMetadataFromAttributes(flatAttributes)
+}
+
+// convertOpenSeaAttributeValue is used to convert the JSON value of an attribute to a value
+// accepted by NewTokenMetadataFromAttributes, according to its display type.
+func convertOpenSeaAttributeValue(name AttributeName, attribute OpenSeaAttribute) (interface{}, error) {
+	switch attribute.DisplayType {
+	case DisplayTypeNumber, DisplayTypeBoostNumber, DisplayTypeBoostPercentage:
+		switch v := attribute.Value.(type) {
+		case json.Number:
+			return convertJSONNumber(v), nil
+		case float64, int64, int:
+			return v, nil
+		case string:
+			if value, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil && !math.IsInf(value, 0) {
+				return convertJSONNumber(json.Number(strings.TrimSpace(v))), nil
+			}
+		}
+		return nil, errors.Wrapf(ErrInvalidTokenMetadata, "attribute %q must be a number: %v", name, attribute.Value)
+	case DisplayTypeDate:
+		switch v := attribute.Value.(type) {
+		case json.Number:
+			if seconds, err := v.Float64(); err == nil {
+				return time.Unix(int64(seconds), 0), nil
+			}
+		case float64:
+			return time.Unix(int64(v), 0), nil
+		case int64:
+			return time.Unix(v, 0), nil
+		case int:
+			return time.Unix(int64(v), 0), nil
+		case time.Time:
+			return v, nil
+		case string:
+			if seconds, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+				return time.Unix(seconds, 0), nil
+			}
+			if date, err := time.Parse(time.RFC3339, strings.TrimSpace(v)); err == nil {
+				return date, nil
+			}
+		}
+		return nil, errors.Wrapf(ErrInvalidTokenMetadata, "attribute %q must be a date: %v", name, attribute.Value)
+	}
+	switch v := attribute.Value.(type) {
+	case json.Number:
+		return convertJSONNumber(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case string, float64, int64, int:
+		return v, nil
+	default:
+		return nil, &InvalidAttributeTypeError{
+			Name: name,
+			Type: reflect.TypeOf(v),
+		}
+	}
+}
+
+// convertJSONNumber is used to keep integers as int64 and other numbers as float64
+func convertJSONNumber(number json.Number) interface{} {
+	if v, err := number.Int64(); err == nil {
+		return v
+	}
+	v, _ := number.Float64()
+	return v
+}
+
+// mergeAttributeValues is used to merge the values of traits sharing the same name. A single value,
+// or equal values of the same type, are kept as they are, equal values of different types, such as
+// "5" and 5, become their formatted string, otherwise the distinct values are formatted, sorted and
+// joined by ", ".
+func mergeAttributeValues(values []interface{}) interface{} {
+	labels := NewSet[string](len(values))
+	sameType := true
+	for _, value := range values {
+		labels.Add(formatAttributeValue(value))
+		sameType = sameType && reflect.TypeOf(value) == reflect.TypeOf(values[0])
+	}
+	sortedLabels := labels.List()
+	if len(sortedLabels) == 1 {
+		if sameType {
+			return values[0]
+		}
+		return sortedLabels[0]
+	}
+	sort.Strings(sortedLabels)
+	return strings.Join(sortedLabels, ", ")
+}
+
+// formatAttributeValue is used to get the normalized string form of an attribute value
+func formatAttributeValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return NormalizeAttributeString(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int:
+		return strconv.Itoa(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return ""
+	}
+}
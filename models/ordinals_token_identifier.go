@@ -0,0 +1,127 @@
+package models
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// OrdinalsInscriptionTokenIdentifier indicates that this token is identified by its Bitcoin Ordinals
+// inscription ID, which is the ID of the reveal transaction followed by the index of the inscription
+// in that transaction, e.g. 6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799i0.
+type OrdinalsInscriptionTokenIdentifier struct {
+	txID  string
+	index uint64
+}
+
+var _ ITokenIdentifier = &OrdinalsInscriptionTokenIdentifier{}
+
+// NewOrdinalsInscriptionTokenIdentifier is the constructor of OrdinalsInscriptionTokenIdentifier,
+// it fails if the inscription ID is not 64 hexadecimal characters followed by i and a decimal index.
+func NewOrdinalsInscriptionTokenIdentifier(inscriptionID string) (OrdinalsInscriptionTokenIdentifier, error) {
+	txID, index, found := strings.Cut(inscriptionID, "i")
+	if !found || len(txID) != 64 {
+		return OrdinalsInscriptionTokenIdentifier{}, errors.Wrapf(
+			ErrInvalidTokenIdentifier, "%q is not an inscription ID", inscriptionID,
+		)
+	}
+	if _, err := hex.DecodeString(txID); err != nil {
+		return OrdinalsInscriptionTokenIdentifier{}, errors.Wrapf(
+			ErrInvalidTokenIdentifier, "%q is not an inscription ID", inscriptionID,
+		)
+	}
+	idx, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return OrdinalsInscriptionTokenIdentifier{}, errors.Wrapf(
+			ErrInvalidTokenIdentifier, "%q is not an inscription ID", inscriptionID,
+		)
+	}
+	return OrdinalsInscriptionTokenIdentifier{
+		txID:  strings.ToLower(txID),
+		index: idx,
+	}, nil
+}
+
+// IdentifierType is used to obtain the identifier type of the current Token.
+func (c OrdinalsInscriptionTokenIdentifier) IdentifierType() IdentifierType {
+	return IdentifierTypeOrdinalsInscription
+}
+
+// InscriptionID is used to get the lower-case inscription ID of the token
+func (c OrdinalsInscriptionTokenIdentifier) InscriptionID() string {
+	return c.txID + "i" + strconv.FormatUint(c.index, 10)
+}
+
+// TxID is used to get the ID of the transaction which revealed the inscription
+func (c OrdinalsInscriptionTokenIdentifier) TxID() string {
+	return c.txID
+}
+
+// Index is used to get the index of the inscription in its reveal transaction
+func (c OrdinalsInscriptionTokenIdentifier) Index() uint64 {
+	return c.index
+}
+
+// String is used to get the canonical form of the identifier, e.g. ordinals_inscription:abci0.
+func (c OrdinalsInscriptionTokenIdentifier) String() string {
+	return string(c.IdentifierType()) + ":" + c.InscriptionID()
+}
+
+// Equal is used to determine whether the identifier identifies the same token as other.
+func (c OrdinalsInscriptionTokenIdentifier) Equal(other ITokenIdentifier) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare is used to compare the identifier with other, identifiers of the same type
+// are ordered by transaction ID, then by index.
+func (c OrdinalsInscriptionTokenIdentifier) Compare(other ITokenIdentifier) int {
+	o, ok := dereferenceTokenIdentifier(other).(OrdinalsInscriptionTokenIdentifier)
+	if !ok {
+		return compareTokenIdentifierStrings(c, other)
+	}
+	if cmp := strings.Compare(c.txID, o.txID); cmp != 0 {
+		return cmp
+	}
+	switch {
+	case c.index < o.index:
+		return -1
+	case c.index > o.index:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ordinalsInscriptionTokenIdentifierJSON is the JSON form of OrdinalsInscriptionTokenIdentifier
+type ordinalsInscriptionTokenIdentifierJSON struct {
+	IdentifierType IdentifierType `json:"identifier_type"`
+	InscriptionID  string         `json:"inscription_id"`
+}
+
+// MarshalJSON is used to encode the identifier as a JSON object holding its identifier type
+func (c OrdinalsInscriptionTokenIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ordinalsInscriptionTokenIdentifierJSON{
+		IdentifierType: c.IdentifierType(),
+		InscriptionID:  c.InscriptionID(),
+	})
+}
+
+// UnmarshalJSON is used to decode the identifier from the JSON object produced by MarshalJSON
+func (c *OrdinalsInscriptionTokenIdentifier) UnmarshalJSON(data []byte) error {
+	var v ordinalsInscriptionTokenIdentifierJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.IdentifierType != IdentifierTypeOrdinalsInscription {
+		return errors.Wrapf(ErrInvalidTokenIdentifier, "unexpected identifier type %q", v.IdentifierType)
+	}
+	identifier, err := NewOrdinalsInscriptionTokenIdentifier(v.InscriptionID)
+	if err != nil {
+		return err
+	}
+	*c = identifier
+	return nil
+}
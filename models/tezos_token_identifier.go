@@ -0,0 +1,147 @@
+package models
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// base58Alphabet is the alphabet of base58 encoded addresses, which excludes 0, O, I and l
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// TezosFA2TokenIdentifier indicates that this token is identified by the address of its FA2 contract
+// and its token ID. This identifier is based off of the TZIP-12 multi-asset interface, where unique
+// tokens belong to the same contract but have their own natural number token id.
+type TezosFA2TokenIdentifier struct {
+	contractAddress string
+	tokenID         *big.Int
+}
+
+var _ ITokenIdentifier = &TezosFA2TokenIdentifier{}
+
+// NewTezosFA2TokenIdentifier is the constructor of TezosFA2TokenIdentifier. It fails if the contract
+// address is not an originated KT1 address, or if the token ID is negative.
+func NewTezosFA2TokenIdentifier(contractAddress string, tokenID *big.Int) (TezosFA2TokenIdentifier, error) {
+	if !isTezosContractAddress(contractAddress) {
+		return TezosFA2TokenIdentifier{}, errors.Wrapf(
+			ErrInvalidTokenIdentifier, "%q is not a Tezos contract address", contractAddress,
+		)
+	}
+	if tokenID == nil || tokenID.Sign() < 0 {
+		return TezosFA2TokenIdentifier{}, errors.Wrapf(
+			ErrInvalidTokenIdentifier, "Tezos token ID %v must be a natural number", tokenID,
+		)
+	}
+	return TezosFA2TokenIdentifier{
+		contractAddress: contractAddress,
+		tokenID:         new(big.Int).Set(tokenID),
+	}, nil
+}
+
+// ParseTezosFA2TokenIdentifier is the constructor of TezosFA2TokenIdentifier for a token ID
+// written in decimal.
+func ParseTezosFA2TokenIdentifier(contractAddress string, tokenID string) (TezosFA2TokenIdentifier, error) {
+	id, ok := new(big.Int).SetString(strings.TrimSpace(tokenID), 10)
+	if !ok {
+		return TezosFA2TokenIdentifier{}, errors.Wrapf(
+			ErrInvalidTokenIdentifier, "Tezos token ID %q must be a natural number", tokenID,
+		)
+	}
+	return NewTezosFA2TokenIdentifier(contractAddress, id)
+}
+
+// isTezosContractAddress is used to check whether the address is a base58 encoded KT1 address
+func isTezosContractAddress(address string) bool {
+	if len(address) != 36 || !strings.HasPrefix(address, "KT1") {
+		return false
+	}
+	for _, c := range address {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// IdentifierType is used to obtain the identifier type of the current Token.
+func (c TezosFA2TokenIdentifier) IdentifierType() IdentifierType {
+	return IdentifierTypeTezosFA2
+}
+
+// ContractAddress is used to get the KT1 address of the contract the token belongs to
+func (c TezosFA2TokenIdentifier) ContractAddress() string {
+	return c.contractAddress
+}
+
+// TokenID is used to get a copy of the ID of the token in its contract
+func (c TezosFA2TokenIdentifier) TokenID() *big.Int {
+	return new(big.Int).Set(c.bigTokenID())
+}
+
+// bigTokenID is used to get the token ID, the zero value of TezosFA2TokenIdentifier has token ID 0
+func (c TezosFA2TokenIdentifier) bigTokenID() *big.Int {
+	if c.tokenID == nil {
+		return new(big.Int)
+	}
+	return c.tokenID
+}
+
+// String is used to get the canonical form of the identifier, e.g. tezos_fa2:KT1abc:123.
+func (c TezosFA2TokenIdentifier) String() string {
+	return string(c.IdentifierType()) + ":" + c.contractAddress + ":" + c.bigTokenID().String()
+}
+
+// Equal is used to determine whether the identifier identifies the same token as other.
+func (c TezosFA2TokenIdentifier) Equal(other ITokenIdentifier) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare is used to compare the identifier with other, identifiers of the same type
+// are ordered by contract address, then by token ID.
+func (c TezosFA2TokenIdentifier) Compare(other ITokenIdentifier) int {
+	o, ok := dereferenceTokenIdentifier(other).(TezosFA2TokenIdentifier)
+	if !ok {
+		return compareTokenIdentifierStrings(c, other)
+	}
+	if cmp := strings.Compare(c.contractAddress, o.contractAddress); cmp != 0 {
+		return cmp
+	}
+	return c.bigTokenID().Cmp(o.bigTokenID())
+}
+
+// tezosFA2TokenIdentifierJSON is the JSON form of TezosFA2TokenIdentifier,
+// the token ID is a JSON number, or a JSON string when decoding.
+type tezosFA2TokenIdentifierJSON struct {
+	IdentifierType  IdentifierType  `json:"identifier_type"`
+	ContractAddress string          `json:"contract_address"`
+	TokenID         json.RawMessage `json:"token_id"`
+}
+
+// MarshalJSON is used to encode the identifier as a JSON object holding its identifier type
+func (c TezosFA2TokenIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tezosFA2TokenIdentifierJSON{
+		IdentifierType:  c.IdentifierType(),
+		ContractAddress: c.contractAddress,
+		TokenID:         json.RawMessage(c.bigTokenID().String()),
+	})
+}
+
+// UnmarshalJSON is used to decode the identifier from the JSON object produced by MarshalJSON,
+// the token ID may also be a JSON string.
+func (c *TezosFA2TokenIdentifier) UnmarshalJSON(data []byte) error {
+	var v tezosFA2TokenIdentifierJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.IdentifierType != IdentifierTypeTezosFA2 {
+		return errors.Wrapf(ErrInvalidTokenIdentifier, "unexpected identifier type %q", v.IdentifierType)
+	}
+	identifier, err := ParseTezosFA2TokenIdentifier(v.ContractAddress, strings.Trim(string(v.TokenID), `"`))
+	if err != nil {
+		return err
+	}
+	*c = identifier
+	return nil
+}
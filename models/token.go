@@ -1,5 +1,9 @@
 package models
 
+import (
+	"math/big"
+)
+
 // IToken represents a token on the blockchain.
 // Examples of these are non-fungible tokens, or semi-fungible tokens.
 type IToken interface {
@@ -40,23 +44,64 @@ func NewToken(
 }
 
 // NewERC721Token Creates a Token class representing an ERC721 evm token given the following
-// parameters.
+// parameters. It fails if the contract address is malformed, see NormalizeEVMAddress.
 func NewERC721Token(
 	contractAddress string,
 	tokenID int,
 	metadata map[string]interface{},
+) (*Token, error) {
+	tokenIdentifier, err := NewEVMContractTokenIdentifierFromBigInt(contractAddress, big.NewInt(int64(tokenID)))
+	if err != nil {
+		return nil, err
+	}
+	return NewERC721TokenFromIdentifier(tokenIdentifier, metadata)
+}
+
+// NewERC721TokenFromIdentifier Creates a Token class representing an ERC721 evm token given
+// its identifier, which supports token IDs of up to 256 bits.
+func NewERC721TokenFromIdentifier(
+	tokenIdentifier EVMContractTokenIdentifier,
+	metadata map[string]interface{},
+) (*Token, error) {
+	attributes, err := NewTokenMetadataFromAttributes(metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		tokenIdentifier: tokenIdentifier,
+		tokenStandard:   TokenStandardERC721,
+		metadata:        attributes,
+	}, nil
+}
+
+// NewERC1155Token Creates a Token class representing an ERC1155 evm token given the following
+// parameters. It fails if the contract address is malformed, see NormalizeEVMAddress.
+func NewERC1155Token(
+	contractAddress string,
+	tokenID int,
+	metadata map[string]interface{},
+) (*Token, error) {
+	tokenIdentifier, err := NewEVMContractTokenIdentifierFromBigInt(contractAddress, big.NewInt(int64(tokenID)))
+	if err != nil {
+		return nil, err
+	}
+	return NewERC1155TokenFromIdentifier(tokenIdentifier, metadata)
+}
+
+// NewERC1155TokenFromIdentifier Creates a Token class representing an ERC1155 evm token given
+// its identifier, which supports token IDs of up to 256 bits.
+func NewERC1155TokenFromIdentifier(
+	tokenIdentifier EVMContractTokenIdentifier,
+	metadata map[string]interface{},
 ) (*Token, error) {
 	attributes, err := NewTokenMetadataFromAttributes(metadata)
 	if err != nil {
 		return nil, err
 	}
 	return &Token{
-		tokenIdentifier: NewEVMContractTokenIdentifier(
-			contractAddress,
-			tokenID,
-		),
-		tokenStandard: TokenStandardERC721,
-		metadata:      attributes,
+		tokenIdentifier: tokenIdentifier,
+		tokenStandard:   TokenStandardERC1155,
+		metadata:        attributes,
 	}, nil
 }
 
@@ -77,10 +122,20 @@ func (c *Token) Metadata() ITokenMetadata {
 }
 
 // TraitCount is used to return the count of non-null, non-"none" value traits this token has.
+// Numeric or date attributes bucketed into string attributes are only counted once.
 func (c *Token) TraitCount() int {
-	return GetStringAttributesCount(c.metadata.StringAttributes()) +
-		len(c.metadata.NumericAttributes()) +
-		len(c.metadata.DateAttributes())
+	count := GetStringAttributesCount(c.metadata.StringAttributes())
+	for name := range c.metadata.NumericAttributes() {
+		if !c.metadata.AttributeExists(name) {
+			count++
+		}
+	}
+	for name := range c.metadata.DateAttributes() {
+		if !c.metadata.AttributeExists(name) {
+			count++
+		}
+	}
+	return count
 }
 
 // TokenIdentifier is used to obtain the current Token identifier.
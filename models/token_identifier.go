@@ -1,40 +1,124 @@
 package models
 
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // IdentifierType defines the type of identifier
 type IdentifierType string
 
 // defines a set of identifier types
 const (
-	IdentifierTypeEVMContract       IdentifierType = "evm_contract"
-	IdentifierTypeSolanaMintAddress IdentifierType = "solana_mint_address"
+	IdentifierTypeEVMContract         IdentifierType = "evm_contract"
+	IdentifierTypeSolanaMintAddress   IdentifierType = "solana_mint_address"
+	IdentifierTypeTezosFA2            IdentifierType = "tezos_fa2"
+	IdentifierTypeOrdinalsInscription IdentifierType = "ordinals_inscription"
+	IdentifierTypeAptosObject         IdentifierType = "aptos_object"
+	IdentifierTypeSuiObject           IdentifierType = "sui_object"
+	IdentifierTypeCosmosCW721         IdentifierType = "cosmos_cw721"
+)
+
+// defines a set of errors returned when building token identifiers
+var (
+	// ErrInvalidTokenIdentifier is returned when a token identifier can't be parsed
+	ErrInvalidTokenIdentifier = errors.New("invalid token identifier")
+	// ErrInvalidTokenID is returned when an EVM token ID is not an unsigned 256-bit integer
+	ErrInvalidTokenID = errors.New("invalid token ID, must be an unsigned 256-bit integer")
 )
 
+// maxUint256 is the greatest EVM token ID
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
 // ITokenIdentifier is used to specify how the collection is identified and the
-// logic used to group the NFTs together
+// logic used to group the NFTs together.
+//
+// String, Equal and Compare were added after IdentifierType, implementations outside
+// this package must add them.
 type ITokenIdentifier interface {
 	// IdentifierType is used to obtain the identifier type of the current Token.
 	IdentifierType() IdentifierType
+	// String is used to get the canonical form of the identifier, which is the identifier type
+	// followed by the fields of the identifier, separated by colons, e.g. evm_contract:0xabc:123.
+	String() string
+	// Equal is used to determine whether the identifier identifies the same token as other.
+	Equal(other ITokenIdentifier) bool
+	// Compare is used to compare the identifier with other, it returns -1, 0 or +1 if the
+	// identifier is lower than, equal to or greater than other.
+	Compare(other ITokenIdentifier) int
 }
 
 // EVMContractTokenIdentifier indicates that this token is identified by the contract address and token ID number.
 // This identifier is based off of the interface as defined by ERC721 and ERC1155,
 // where unique tokens belong to the same contract but have their own numeral token id.
+// Token IDs are unsigned 256-bit integers.
 type EVMContractTokenIdentifier struct {
 	contractAddress string
-	tokenID         int
+	tokenID         *big.Int
 }
 
 var _ ITokenIdentifier = &EVMContractTokenIdentifier{}
 
-// NewEVMContractTokenIdentifier is the constructor of EVMContractTokenIdentifier
+// NewEVMContractTokenIdentifier is the constructor of EVMContractTokenIdentifier.
+// It fails if the contract address is malformed, see NormalizeEVMAddress, or if the token ID
+// is negative, use NewEVMContractTokenIdentifierFromBigInt for token IDs which don't fit in an int.
 func NewEVMContractTokenIdentifier(
 	contractAddress string,
 	tokenID int,
-) EVMContractTokenIdentifier {
+) (EVMContractTokenIdentifier, error) {
+	return NewEVMContractTokenIdentifierFromBigInt(contractAddress, big.NewInt(int64(tokenID)))
+}
+
+// NewEVMContractTokenIdentifierFromBigInt is the constructor of EVMContractTokenIdentifier
+// for arbitrary token IDs. It fails if the contract address is malformed, see NormalizeEVMAddress,
+// or if the token ID is not an unsigned 256-bit integer.
+func NewEVMContractTokenIdentifierFromBigInt(
+	contractAddress string,
+	tokenID *big.Int,
+) (EVMContractTokenIdentifier, error) {
+	normalizedAddress, err := NormalizeEVMAddress(contractAddress)
+	if err != nil {
+		return EVMContractTokenIdentifier{}, err
+	}
+	if tokenID == nil || tokenID.Sign() < 0 || tokenID.Cmp(maxUint256) > 0 {
+		return EVMContractTokenIdentifier{}, errors.Wrapf(ErrInvalidTokenID, "%v", tokenID)
+	}
 	return EVMContractTokenIdentifier{
-		contractAddress: contractAddress,
-		tokenID:         tokenID,
+		contractAddress: normalizedAddress,
+		tokenID:         new(big.Int).Set(tokenID),
+	}, nil
+}
+
+// ParseEVMContractTokenIdentifier is the constructor of EVMContractTokenIdentifier
+// for a token ID written in decimal, or in hexadecimal with a 0x prefix. It fails if the
+// contract address is malformed, see NormalizeEVMAddress.
+func ParseEVMContractTokenIdentifier(
+	contractAddress string,
+	tokenID string,
+) (EVMContractTokenIdentifier, error) {
+	id, err := ParseEVMTokenID(tokenID)
+	if err != nil {
+		return EVMContractTokenIdentifier{}, err
+	}
+	return NewEVMContractTokenIdentifierFromBigInt(contractAddress, id)
+}
+
+// ParseEVMTokenID is used to parse a token ID written in decimal, or in hexadecimal with
+// a 0x prefix, it fails if the token ID is not an unsigned 256-bit integer.
+func ParseEVMTokenID(value string) (*big.Int, error) {
+	value = strings.TrimSpace(value)
+	base := 10
+	if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		value, base = value[2:], 16
+	}
+	tokenID, ok := new(big.Int).SetString(value, base)
+	if !ok || tokenID.Sign() < 0 || tokenID.Cmp(maxUint256) > 0 {
+		return nil, errors.Wrapf(ErrInvalidTokenID, "%q", value)
 	}
+	return tokenID, nil
 }
 
 // IdentifierType is used to obtain the identifier type of the current Token.
@@ -42,6 +126,92 @@ func (c EVMContractTokenIdentifier) IdentifierType() IdentifierType {
 	return IdentifierTypeEVMContract
 }
 
+// ContractAddress is used to get the lower-case address of the contract the token belongs to
+func (c EVMContractTokenIdentifier) ContractAddress() string {
+	return c.contractAddress
+}
+
+// ChecksumContractAddress is used to get the EIP-55 checksummed address of the contract the
+// token belongs to, it fails for the zero value of EVMContractTokenIdentifier, which has no address.
+func (c EVMContractTokenIdentifier) ChecksumContractAddress() (string, error) {
+	return ChecksumEVMAddress(c.contractAddress)
+}
+
+// TokenID is used to get a copy of the ID of the token in its contract
+func (c EVMContractTokenIdentifier) TokenID() *big.Int {
+	return new(big.Int).Set(c.bigTokenID())
+}
+
+// String is used to get the canonical form of the identifier, e.g. evm_contract:0xabc:123,
+// the token ID is written in decimal.
+func (c EVMContractTokenIdentifier) String() string {
+	return string(c.IdentifierType()) + ":" + c.contractAddress + ":" + c.bigTokenID().String()
+}
+
+// bigTokenID is used to get the token ID, the zero value of EVMContractTokenIdentifier has token ID 0
+func (c EVMContractTokenIdentifier) bigTokenID() *big.Int {
+	if c.tokenID == nil {
+		return new(big.Int)
+	}
+	return c.tokenID
+}
+
+// Equal is used to determine whether the identifier identifies the same token as other.
+func (c EVMContractTokenIdentifier) Equal(other ITokenIdentifier) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare is used to compare the identifier with other, identifiers of the same type
+// are ordered by contract address, then by token ID.
+func (c EVMContractTokenIdentifier) Compare(other ITokenIdentifier) int {
+	o, ok := dereferenceTokenIdentifier(other).(EVMContractTokenIdentifier)
+	if !ok {
+		return compareTokenIdentifierStrings(c, other)
+	}
+	if cmp := strings.Compare(c.contractAddress, o.contractAddress); cmp != 0 {
+		return cmp
+	}
+	return c.bigTokenID().Cmp(o.bigTokenID())
+}
+
+// evmContractTokenIdentifierJSON is the JSON form of EVMContractTokenIdentifier,
+// the token ID is a JSON number, or a JSON string when decoding.
+type evmContractTokenIdentifierJSON struct {
+	IdentifierType  IdentifierType  `json:"identifier_type"`
+	ContractAddress string          `json:"contract_address"`
+	TokenID         json.RawMessage `json:"token_id"`
+}
+
+// MarshalJSON is used to encode the identifier as a JSON object holding its identifier type
+func (c EVMContractTokenIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(evmContractTokenIdentifierJSON{
+		IdentifierType:  c.IdentifierType(),
+		ContractAddress: c.contractAddress,
+		TokenID:         json.RawMessage(c.bigTokenID().String()),
+	})
+}
+
+// UnmarshalJSON is used to decode the identifier from the JSON object produced by MarshalJSON,
+// the token ID may also be a JSON string written in decimal or hexadecimal.
+func (c *EVMContractTokenIdentifier) UnmarshalJSON(data []byte) error {
+	var v evmContractTokenIdentifierJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.IdentifierType != IdentifierTypeEVMContract {
+		return errors.Wrapf(ErrInvalidTokenIdentifier, "unexpected identifier type %q", v.IdentifierType)
+	}
+	identifier, err := ParseEVMContractTokenIdentifier(
+		v.ContractAddress,
+		strings.Trim(string(v.TokenID), `"`),
+	)
+	if err != nil {
+		return err
+	}
+	*c = identifier
+	return nil
+}
+
 // SolanaMintAddressTokenIdentifier indicates that this token is identified by their solana account address.
 // This identifier is based off of the interface defined by the Solana SPL token
 // standard where every such token is declared by creating a mint account.
@@ -62,3 +232,194 @@ func NewSolanaMintAddressTokenIdentifier(mintAddress string) SolanaMintAddressTo
 func (c SolanaMintAddressTokenIdentifier) IdentifierType() IdentifierType {
 	return IdentifierTypeSolanaMintAddress
 }
+
+// MintAddress is used to get the address of the mint account of the token
+func (c SolanaMintAddressTokenIdentifier) MintAddress() string {
+	return c.mintAddress
+}
+
+// String is used to get the canonical form of the identifier, e.g. solana_mint_address:abc.
+func (c SolanaMintAddressTokenIdentifier) String() string {
+	return string(c.IdentifierType()) + ":" + c.mintAddress
+}
+
+// Equal is used to determine whether the identifier identifies the same token as other.
+func (c SolanaMintAddressTokenIdentifier) Equal(other ITokenIdentifier) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare is used to compare the identifier with other, identifiers of the same type
+// are ordered by mint address.
+func (c SolanaMintAddressTokenIdentifier) Compare(other ITokenIdentifier) int {
+	o, ok := dereferenceTokenIdentifier(other).(SolanaMintAddressTokenIdentifier)
+	if !ok {
+		return compareTokenIdentifierStrings(c, other)
+	}
+	return strings.Compare(c.mintAddress, o.mintAddress)
+}
+
+// solanaMintAddressTokenIdentifierJSON is the JSON form of SolanaMintAddressTokenIdentifier
+type solanaMintAddressTokenIdentifierJSON struct {
+	IdentifierType IdentifierType `json:"identifier_type"`
+	MintAddress    string         `json:"mint_address"`
+}
+
+// MarshalJSON is used to encode the identifier as a JSON object holding its identifier type
+func (c SolanaMintAddressTokenIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(solanaMintAddressTokenIdentifierJSON{
+		IdentifierType: c.IdentifierType(),
+		MintAddress:    c.mintAddress,
+	})
+}
+
+// UnmarshalJSON is used to decode the identifier from the JSON object produced by MarshalJSON
+func (c *SolanaMintAddressTokenIdentifier) UnmarshalJSON(data []byte) error {
+	var v solanaMintAddressTokenIdentifierJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.IdentifierType != IdentifierTypeSolanaMintAddress {
+		return errors.Wrapf(ErrInvalidTokenIdentifier, "unexpected identifier type %q", v.IdentifierType)
+	}
+	if v.MintAddress == "" {
+		return errors.Wrap(ErrInvalidTokenIdentifier, "empty mint address")
+	}
+	*c = NewSolanaMintAddressTokenIdentifier(v.MintAddress)
+	return nil
+}
+
+// ParseTokenIdentifier is used to parse the canonical form of an identifier returned by
+// ITokenIdentifier.String.
+func ParseTokenIdentifier(value string) (ITokenIdentifier, error) {
+	identifierType, fields, found := strings.Cut(value, ":")
+	if !found {
+		return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q", value)
+	}
+	switch IdentifierType(identifierType) {
+	case IdentifierTypeEVMContract:
+		idx := strings.LastIndex(fields, ":")
+		if idx < 0 {
+			return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q", value)
+		}
+		identifier, err := ParseEVMContractTokenIdentifier(fields[:idx], fields[idx+1:])
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q: %v", value, err)
+		}
+		return identifier, nil
+	case IdentifierTypeSolanaMintAddress:
+		if fields == "" {
+			return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q: empty mint address", value)
+		}
+		return NewSolanaMintAddressTokenIdentifier(fields), nil
+	case IdentifierTypeTezosFA2:
+		idx := strings.LastIndex(fields, ":")
+		if idx < 0 {
+			return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q", value)
+		}
+		return ParseTezosFA2TokenIdentifier(fields[:idx], fields[idx+1:])
+	case IdentifierTypeOrdinalsInscription:
+		return NewOrdinalsInscriptionTokenIdentifier(fields)
+	case IdentifierTypeAptosObject:
+		return NewAptosObjectTokenIdentifier(fields)
+	case IdentifierTypeSuiObject:
+		return NewSuiObjectTokenIdentifier(fields)
+	case IdentifierTypeCosmosCW721:
+		// bech32 addresses have no colon, while CW721 token IDs may have some
+		contractAddress, tokenID, found := strings.Cut(fields, ":")
+		if !found {
+			return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q", value)
+		}
+		identifier, err := NewCosmosCW721TokenIdentifier(contractAddress, tokenID)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "%q: %v", value, err)
+		}
+		return identifier, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "unknown identifier type %q", identifierType)
+	}
+}
+
+// UnmarshalTokenIdentifierJSON is used to decode an identifier of any type from the JSON
+// object produced by its MarshalJSON method.
+func UnmarshalTokenIdentifierJSON(data []byte) (ITokenIdentifier, error) {
+	var v struct {
+		IdentifierType IdentifierType `json:"identifier_type"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	switch v.IdentifierType {
+	case IdentifierTypeEVMContract:
+		var identifier EVMContractTokenIdentifier
+		if err := json.Unmarshal(data, &identifier); err != nil {
+			return nil, err
+		}
+		return identifier, nil
+	case IdentifierTypeSolanaMintAddress:
+		var identifier SolanaMintAddressTokenIdentifier
+		if err := json.Unmarshal(data, &identifier); err != nil {
+			return nil, err
+		}
+		return identifier, nil
+	case IdentifierTypeTezosFA2:
+		var identifier TezosFA2TokenIdentifier
+		if err := json.Unmarshal(data, &identifier); err != nil {
+			return nil, err
+		}
+		return identifier, nil
+	case IdentifierTypeOrdinalsInscription:
+		var identifier OrdinalsInscriptionTokenIdentifier
+		if err := json.Unmarshal(data, &identifier); err != nil {
+			return nil, err
+		}
+		return identifier, nil
+	case IdentifierTypeAptosObject, IdentifierTypeSuiObject:
+		var identifier MoveObjectTokenIdentifier
+		if err := json.Unmarshal(data, &identifier); err != nil {
+			return nil, err
+		}
+		return identifier, nil
+	case IdentifierTypeCosmosCW721:
+		var identifier CosmosCW721TokenIdentifier
+		if err := json.Unmarshal(data, &identifier); err != nil {
+			return nil, err
+		}
+		return identifier, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidTokenIdentifier, "unknown identifier type %q", v.IdentifierType)
+	}
+}
+
+// CompareTokenIdentifiers is used to compare two token identifiers, it returns -1, 0 or +1
+// if a is lower than, equal to or greater than b. Identifiers of different types are ordered
+// by identifier type.
+func CompareTokenIdentifiers(a, b ITokenIdentifier) int {
+	return a.Compare(b)
+}
+
+// compareTokenIdentifierStrings is used to compare identifiers of different implementations,
+// by identifier type first, then by their canonical forms.
+func compareTokenIdentifierStrings(a, b ITokenIdentifier) int {
+	if cmp := strings.Compare(string(a.IdentifierType()), string(b.IdentifierType())); cmp != 0 {
+		return cmp
+	}
+	return strings.Compare(a.String(), b.String())
+}
+
+func dereferenceTokenIdentifier(identifier ITokenIdentifier) ITokenIdentifier {
+	switch v := identifier.(type) {
+	case *EVMContractTokenIdentifier:
+		return *v
+	case *SolanaMintAddressTokenIdentifier:
+		return *v
+	case *TezosFA2TokenIdentifier:
+		return *v
+	case *OrdinalsInscriptionTokenIdentifier:
+		return *v
+	case *MoveObjectTokenIdentifier:
+		return *v
+	case *CosmosCW721TokenIdentifier:
+		return *v
+	}
+	return identifier
+}
@@ -1,11 +1,25 @@
 package models
 
 import (
+	"fmt"
+	"reflect"
 	"time"
-
-	"github.com/pkg/errors"
 )
 
+// InvalidAttributeTypeError is returned when an attribute value has a type
+// which can't be converted to a string, numeric or date attribute.
+type InvalidAttributeTypeError struct {
+	// Name is the normalized name of the attribute
+	Name AttributeName
+	// Type is the Go type of the attribute value
+	Type reflect.Type
+}
+
+// Error is used to describe the invalid attribute
+func (e *InvalidAttributeTypeError) Error() string {
+	return fmt.Sprintf("Provided attribute %q value has invalid type: %v, Must be string.", e.Name, e.Type)
+}
+
 // ITokenMetadata represent EIP-721 or EIP-1115 compatible metadata structure
 type ITokenMetadata interface {
 	// StringAttributes is returns the mapping of attribute name string attribute value
@@ -67,7 +81,10 @@ func NewTokenMetadataFromAttributes(attributes map[string]interface{}) (*TokenMe
 				normalizeAttributeName, v.Unix(),
 			)
 		default:
-			return nil, errors.Errorf("Provided attribute value has invalid type: %T, Must be string.", v)
+			return nil, &InvalidAttributeTypeError{
+				Name: normalizeAttributeName,
+				Type: reflect.TypeOf(v),
+			}
 		}
 	}
 	return &TokenMetadata{
@@ -77,6 +94,26 @@ func NewTokenMetadataFromAttributes(attributes map[string]interface{}) (*TokenMe
 	}, nil
 }
 
+// copyTokenMetadata is used to copy the attributes of metadata, so that adding attributes
+// to the copy leaves metadata unchanged.
+func copyTokenMetadata(metadata ITokenMetadata) *TokenMetadata {
+	c := &TokenMetadata{
+		stringAttributes:  make(map[AttributeName]IStringAttribute, len(metadata.StringAttributes())),
+		numericAttributes: make(map[AttributeName]INumericAttribute, len(metadata.NumericAttributes())),
+		dateAttributes:    make(map[AttributeName]IDateAttribute, len(metadata.DateAttributes())),
+	}
+	for name, attribute := range metadata.StringAttributes() {
+		c.stringAttributes[name] = attribute
+	}
+	for name, attribute := range metadata.NumericAttributes() {
+		c.numericAttributes[name] = attribute
+	}
+	for name, attribute := range metadata.DateAttributes() {
+		c.dateAttributes[name] = attribute
+	}
+	return c
+}
+
 // NumericAttributes is returns the mapping of attribute name numeric attribute value
 func (c *TokenMetadata) NumericAttributes() map[AttributeName]INumericAttribute {
 	return c.numericAttributes
@@ -4,17 +4,34 @@ package models
 type ITokenRankingFeatures interface {
 	// UniqueAttributeCount is used to get the unique attribute count
 	UniqueAttributeCount() int
+	// Feature is used to get the value of the feature with the given name
+	Feature(name string) (float64, bool)
+	// Features is used to get the values of all features by name
+	Features() map[string]float64
 }
 
 // TokenRankingFeatures is used to extract features from tokens
 type TokenRankingFeatures struct {
 	uniqueAttributeCount int
+	features             map[string]float64
 }
 
 // NewTokenRankingFeatures is the constructor of TokenRankingFeatures
 func NewTokenRankingFeatures(uniqueAttributeCount int) *TokenRankingFeatures {
 	return &TokenRankingFeatures{
 		uniqueAttributeCount: uniqueAttributeCount,
+		features: map[string]float64{
+			UniqueAttributeCountFeatureName: float64(uniqueAttributeCount),
+		},
+	}
+}
+
+// NewTokenRankingFeaturesFromMap is used to create TokenRankingFeatures from the values of
+// features by name, the unique attribute count is read from UniqueAttributeCountFeatureName.
+func NewTokenRankingFeaturesFromMap(features map[string]float64) *TokenRankingFeatures {
+	return &TokenRankingFeatures{
+		uniqueAttributeCount: int(features[UniqueAttributeCountFeatureName]),
+		features:             features,
 	}
 }
 
@@ -25,16 +42,51 @@ func (c *TokenRankingFeatures) UniqueAttributeCount() int {
 	return c.uniqueAttributeCount
 }
 
+// Feature is used to get the value of the feature with the given name
+func (c *TokenRankingFeatures) Feature(name string) (float64, bool) {
+	value, exists := c.features[name]
+	return value, exists
+}
+
+// Features is used to get a copy of the values of all features by name
+func (c *TokenRankingFeatures) Features() map[string]float64 {
+	features := make(map[string]float64, len(c.features))
+	for name, value := range c.features {
+		features[name] = value
+	}
+	return features
+}
+
 // ExtractUniqueAttributeCount is used to extract unique attributes count from the token
 func ExtractUniqueAttributeCount(
 	token IToken, collection ICollection,
 ) ITokenRankingFeatures {
-	uniqueAttributesCount := 0
+	return NewTokenRankingFeatures(countAttributesWithMaxTokens(token, collection, 1))
+}
+
+// ExtractTokenRankingFeatures is used to extract the features of every extractor from the token
+func ExtractTokenRankingFeatures(
+	token IToken, collection ICollection, extractors []IFeatureExtractor,
+) ITokenRankingFeatures {
+	features := make(map[string]float64, len(extractors)+1)
+	for _, extractor := range extractors {
+		features[extractor.Name()] = extractor.Extract(token, collection)
+	}
+	if _, exists := features[UniqueAttributeCountFeatureName]; !exists {
+		features[UniqueAttributeCountFeatureName] = float64(countAttributesWithMaxTokens(token, collection, 1))
+	}
+	return NewTokenRankingFeaturesFromMap(features)
+}
+
+// countAttributesWithMaxTokens is used to count the attributes of the token which are
+// held by at most maxTokens tokens of the collection.
+func countAttributesWithMaxTokens(token IToken, collection ICollection, maxTokens int) int {
+	attributesCount := 0
 	for _, stringAttribute := range token.Metadata().StringAttributes() {
 		count := collection.TotalTokensWithAttributes(stringAttribute)
-		if count == 1 {
-			uniqueAttributesCount++
+		if count <= maxTokens {
+			attributesCount++
 		}
 	}
-	return NewTokenRankingFeatures(uniqueAttributesCount)
+	return attributesCount
 }
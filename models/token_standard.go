@@ -16,4 +16,45 @@ const (
 
 	// TokenStandardMetaplexNonFungible is https://docs.metaplex.com/programs/token-metadata/token-standard
 	TokenStandardMetaplexNonFungible TokenStandard = "metaplex_non_fungible"
+
+	// -- Tezos token standards
+
+	// TokenStandardFA2NonFungible is a non-fungible token of an FA2 contract, see
+	// https://gitlab.com/tezos/tzip/-/blob/master/proposals/tzip-12/tzip-12.md
+	TokenStandardFA2NonFungible TokenStandard = "fa2_non_fungible"
+
+	// -- Bitcoin token standards
+
+	// TokenStandardOrdinalsInscription is https://docs.ordinals.com/inscriptions.html
+	TokenStandardOrdinalsInscription TokenStandard = "ordinals_inscription"
+
+	// -- Aptos token standards
+
+	// TokenStandardAptosDigitalAsset is https://aptos.dev/standards/digital-asset
+	TokenStandardAptosDigitalAsset TokenStandard = "aptos_digital_asset"
+
+	// -- Sui token standards
+
+	// TokenStandardSuiObject is a non-fungible Sui object with a Display, see
+	// https://docs.sui.io/standards/display
+	TokenStandardSuiObject TokenStandard = "sui_object"
+
+	// -- Cosmos token standards
+
+	// TokenStandardCW721 is https://github.com/CosmWasm/cw-nfts/tree/main/packages/cw721
+	TokenStandardCW721 TokenStandard = "cw721"
 )
+
+// NonFungibleTokenStandards is used to get the token standards of which every token is unique,
+// so that they can be scored without knowing their supplies.
+func NonFungibleTokenStandards() []TokenStandard {
+	return []TokenStandard{
+		TokenStandardERC721,
+		TokenStandardMetaplexNonFungible,
+		TokenStandardFA2NonFungible,
+		TokenStandardOrdinalsInscription,
+		TokenStandardAptosDigitalAsset,
+		TokenStandardSuiObject,
+		TokenStandardCW721,
+	}
+}
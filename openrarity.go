@@ -11,25 +11,97 @@ func NewOpenRarityScorer() scoring.IScorer {
 	return scoring.NewScorer(handlers.NewInformationContentScoringHandler())
 }
 
+// NewScorerByName is used to build a scorer with the score handler registered under the given name,
+// see handlers for the names of the built-in handlers.
+func NewScorerByName(name string, opts ...scoring.ScorerOption) (scoring.IScorer, error) {
+	handler, err := scoring.NewScoreHandler(name)
+	if err != nil {
+		return nil, err
+	}
+	return scoring.NewScorer(handler, opts...), nil
+}
+
+// NewTraitNormalizedScorer is used to build a scorer which sums the inverse probabilities
+// of the traits of a token, each weighted by one over the number of values of the trait.
+func NewTraitNormalizedScorer() scoring.IScorer {
+	return scoring.NewScorer(handlers.NewTraitNormalizedScoringHandler())
+}
+
 // export a set of types
 type (
 	IToken                = models.IToken
 	ICollection           = models.ICollection
+	IERC1155Collection    = models.IERC1155Collection
 	ITokenRarity          = models.ITokenRarity
 	IStringAttribute      = models.IStringAttribute
 	IAttribute            = models.IAttribute
 	ITokenMetadata        = models.ITokenMetadata
 	ITokenRankingFeatures = models.ITokenRankingFeatures
 	ITokenIdentifier      = models.ITokenIdentifier
+	IFeatureExtractor     = models.IFeatureExtractor
+	INumericBinner        = models.INumericBinner
+	IDateBinner           = models.IDateBinner
+	CollectionOption      = models.CollectionOption
+
+	// InvalidAttributeTypeError is returned when an attribute value has a type
+	// which can't be converted to a string, numeric or date attribute.
+	InvalidAttributeTypeError = models.InvalidAttributeTypeError
+)
+
+// export a set of errors
+var (
+	// ErrUnsupportedStandard is returned when a collection holds tokens of unsupported standards
+	ErrUnsupportedStandard = scoring.ErrUnsupportedStandard
+	// ErrNumericTraitsUnsupported is returned when a collection holds numeric or date traits
+	// which have not been bucketed into string attributes
+	ErrNumericTraitsUnsupported = scoring.ErrNumericTraitsUnsupported
+	// ErrUnknownScoreHandler is returned when no score handler is registered under a name
+	ErrUnknownScoreHandler = scoring.ErrUnknownScoreHandler
 )
 
 // export a set of methods
 var (
 	// NewCollection is the constructor of Collection
 	NewCollection = models.NewCollection
+	// NewERC1155Collection is the constructor of ERC1155Collection, supplies holds the
+	// edition supply of every token in tokens.
+	NewERC1155Collection = models.NewERC1155Collection
 	// NewERC721Token Creates a Token class representing an ERC721 evm token given the following
 	// parameters.
 	NewERC721Token = models.NewERC721Token
+	// NewERC721TokenFromIdentifier Creates a Token class representing an ERC721 evm token given
+	// its identifier, which supports token IDs of up to 256 bits.
+	NewERC721TokenFromIdentifier = models.NewERC721TokenFromIdentifier
+	// ParseEVMContractTokenIdentifier is the constructor of EVMContractTokenIdentifier
+	// for a token ID written in decimal, or in hexadecimal with a 0x prefix.
+	ParseEVMContractTokenIdentifier = models.ParseEVMContractTokenIdentifier
+	// NormalizeEVMAddress is used to validate an EVM address and convert it to its canonical
+	// lower-case form.
+	NormalizeEVMAddress = models.NormalizeEVMAddress
+	// NewERC1155Token Creates a Token class representing an ERC1155 evm token given the following
+	// parameters.
+	NewERC1155Token = models.NewERC1155Token
 	// NewToken is the constructor of Token
 	NewToken = models.NewToken
+	// NewMetaplexToken Creates a Token class representing a Metaplex non-fungible token given
+	// its mint address and its off-chain JSON document.
+	NewMetaplexToken = models.NewMetaplexToken
+	// NewTokenMetadataFromOpenSeaJSON is used to create TokenMetadata from an ERC721 metadata JSON
+	// document with OpenSea attributes.
+	NewTokenMetadataFromOpenSeaJSON = models.NewTokenMetadataFromOpenSeaJSON
+	// RegisterFeatureExtractor makes a feature extractor available to the ranking of collections.
+	// It panics if an extractor with the same name is already registered.
+	RegisterFeatureExtractor = models.RegisterFeatureExtractor
+	// WithNumericBinner is used to bucket every numeric attribute of the collection with
+	// the given binner, unless a dedicated binner is set by WithAttributeNumericBinner.
+	WithNumericBinner = models.WithNumericBinner
+	// WithAttributeNumericBinner is used to bucket the numeric attribute with the given name
+	// with the given binner.
+	WithAttributeNumericBinner = models.WithAttributeNumericBinner
+	// WithDateBinner is used to bucket every date attribute of the collection with
+	// the given binner, unless a dedicated binner is set by WithAttributeDateBinner.
+	WithDateBinner = models.WithDateBinner
+	// WithAttributeDateBinner is used to bucket the date attribute with the given name
+	// with the given binner.
+	WithAttributeDateBinner = models.WithAttributeDateBinner
 )
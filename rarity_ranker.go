@@ -9,12 +9,44 @@ import (
 	"github.com/pkg/errors"
 )
 
+// defines a set of errors returned by the rarity ranker
+var (
+	// ErrScoreDimensionMismatch is returned when the scorer doesn't return one score per token
+	ErrScoreDimensionMismatch = errors.New("dimension of scores doesn't match dimension of tokens")
+	// ErrUnknownRankingMode is returned when the rarity ranker is configured with an unknown ranking mode
+	ErrUnknownRankingMode = errors.New("unknown ranking mode")
+)
+
+// RankingMode defines how ranks are assigned to tokens with the same score
+type RankingMode string
+
+// defines a set of ranking modes
+const (
+	// RankingModeRank assigns the same rank to tied tokens and skips the following ranks.
+	// Example: 1, 2, 2, 2, 5.
+	RankingModeRank RankingMode = "rank"
+	// RankingModeDenseRank assigns the same rank to tied tokens without skipping ranks.
+	// Example: 1, 2, 2, 2, 3.
+	RankingModeDenseRank RankingMode = "dense_rank"
+	// RankingModeOrdinal assigns distinct ranks to all tokens, tied tokens are ranked by token identifier.
+	// Example: 1, 2, 3, 4, 5.
+	RankingModeOrdinal RankingMode = "ordinal"
+)
+
+// defines the default tolerances used to consider two scores equal,
+// which are the default parameters of math.isclose in python. Earlier versions
+// used an absolute tolerance of 1e-9, see WithTolerance to restore it.
+const (
+	DefaultRelTolerance = 1e-9
+	DefaultAbsTolerance = 0
+)
+
 // IRarityRanker is used to rank a set of tokens given their rarity scores.
 type IRarityRanker interface {
 	// RankCollection is used to rank tokens in the collection with the default scorer implementation.
 	// Scores that are higher indicate a higher rarity, and thus a lower rank.
 	//
-	// Tokens with the same score will be assigned the same rank, e.g. we use RANK
+	// By default, tokens with the same score will be assigned the same rank, e.g. we use RANK
 	// (vs. DENSE_RANK).
 	// Example: 1, 2, 2, 2, 5.
 	// Scores are considered the same rank if they are within about 9 decimal digits
@@ -22,9 +54,13 @@ type IRarityRanker interface {
 	RankCollection(collection models.ICollection, scorer scoring.IScorer) ([]models.ITokenRarity, error)
 	// SetRarityRanks is used to rank a set of tokens according to OpenRarity algorithm.
 	// To account for additional factors like unique items in a collection,
-	// OpenRarity implements multifactorial sort. Current sort algorithm uses two
+	// OpenRarity implements multifactorial sort. By default the sort algorithm uses two
 	// factors: unique attributes count and Information Content score, in order.
-	// Tokens with the same score will be assigned the same rank, e.g. we use RANK
+	// Tokens are considered tied when they are equal on every sort factor, tied tokens
+	// are ordered by token identifier. The given slice is left untouched. Earlier versions
+	// only compared scores, which tied tokens with the same score but a different unique
+	// attributes count.
+	// By default, tokens with the same score will be assigned the same rank, e.g. we use RANK
 	// (vs. DENSE_RANK).
 	// Example: 1, 2, 2, 2, 5.
 	// Scores are considered the same rank if they are within about 9 decimal digits
@@ -33,19 +69,70 @@ type IRarityRanker interface {
 }
 
 // RarityRanker is used to rank a set of tokens given their rarity scores.
-type RarityRanker struct{}
+type RarityRanker struct {
+	relTolerance float64
+	absTolerance float64
+	mode         RankingMode
+	sortKeys     []SortKey
+	extractors   []models.IFeatureExtractor
+}
 
 var _ IRarityRanker = &RarityRanker{}
 
+// RarityRankerOption is used to configure the optional behaviours of RarityRanker
+type RarityRankerOption func(c *RarityRanker)
+
+// WithTolerance is used to set the relative and absolute tolerances used to consider
+// two scores equal, with the same semantics as math.isclose in python.
+func WithTolerance(relTolerance, absTolerance float64) RarityRankerOption {
+	return func(c *RarityRanker) {
+		c.relTolerance = relTolerance
+		c.absTolerance = absTolerance
+	}
+}
+
+// WithRankingMode is used to set how ranks are assigned to tokens with the same score
+func WithRankingMode(mode RankingMode) RarityRankerOption {
+	return func(c *RarityRanker) {
+		c.mode = mode
+	}
+}
+
+// WithSortKeys is used to replace the default factors of the multifactorial sort, tokens
+// are compared by the first sort key, then by the next one when the values are equal.
+func WithSortKeys(sortKeys ...SortKey) RarityRankerOption {
+	return func(c *RarityRanker) {
+		c.sortKeys = sortKeys
+	}
+}
+
+// WithFeatureExtractors is used to replace the registered feature extractors used by
+// RankCollection to compute the ranking features of tokens. The unique attribute count
+// is always computed, since the default sort relies on it.
+func WithFeatureExtractors(extractors ...models.IFeatureExtractor) RarityRankerOption {
+	return func(c *RarityRanker) {
+		c.extractors = extractors
+	}
+}
+
 // NewRarityRanker is the constructor of RarityRanker
-func NewRarityRanker() *RarityRanker {
-	return &RarityRanker{}
+func NewRarityRanker(opts ...RarityRankerOption) *RarityRanker {
+	c := &RarityRanker{
+		relTolerance: DefaultRelTolerance,
+		absTolerance: DefaultAbsTolerance,
+		mode:         RankingModeRank,
+		sortKeys:     DefaultSortKeys(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // RankCollection is used to rank tokens in the collection with the default scorer implementation.
 // Scores that are higher indicate a higher rarity, and thus a lower rank.
 //
-// Tokens with the same score will be assigned the same rank, e.g. we use RANK
+// By default, tokens with the same score will be assigned the same rank, e.g. we use RANK
 // (vs. DENSE_RANK).
 // Example: 1, 2, 2, 2, 5.
 // Scores are considered the same rank if they are within about 9 decimal digits
@@ -60,11 +147,15 @@ func (c *RarityRanker) RankCollection(collection models.ICollection, scorer scor
 		return nil, err
 	}
 	if len(tokens) != len(scores) {
-		return nil, errors.New("dimension of scores doesn't match dimension of tokens")
+		return nil, ErrScoreDimensionMismatch
+	}
+	extractors := c.extractors
+	if extractors == nil {
+		extractors = models.FeatureExtractors()
 	}
 	tokenRarities := make([]models.ITokenRarity, 0, len(tokens))
 	for idx, token := range tokens {
-		tokenFeatures := models.ExtractUniqueAttributeCount(token, collection)
+		tokenFeatures := models.ExtractTokenRankingFeatures(token, collection, extractors)
 		tokenRarities = append(tokenRarities,
 			models.NewTokenRarity(token, scores[idx], tokenFeatures),
 		)
@@ -74,42 +165,123 @@ func (c *RarityRanker) RankCollection(collection models.ICollection, scorer scor
 
 // SetRarityRanks is used to rank a set of tokens according to OpenRarity algorithm.
 // To account for additional factors like unique items in a collection,
-// OpenRarity implements multifactorial sort. Current sort algorithm uses two
+// OpenRarity implements multifactorial sort. By default the sort algorithm uses two
 // factors: unique attributes count and Information Content score, in order.
-// Tokens with the same score will be assigned the same rank, e.g. we use RANK
+// Tokens are considered tied when they are equal on every sort factor, tied tokens
+// are ordered by token identifier. The given slice is left untouched. Earlier versions
+// only compared scores, which tied tokens with the same score but a different unique
+// attributes count.
+// By default, tokens with the same score will be assigned the same rank, e.g. we use RANK
 // (vs. DENSE_RANK).
 // Example: 1, 2, 2, 2, 5.
 // Scores are considered the same rank if they are within about 9 decimal digits
 // of each other.
 func (c *RarityRanker) SetRarityRanks(tokenRarities []models.ITokenRarity) ([]models.ITokenRarity, error) {
-	sort.Slice(tokenRarities, func(i, j int) bool {
-		if delta := tokenRarities[i].TokenFeatures().UniqueAttributeCount() -
-			tokenRarities[j].TokenFeatures().UniqueAttributeCount(); delta != 0 {
-			return delta > 0
+	switch c.mode {
+	case RankingModeRank, RankingModeDenseRank, RankingModeOrdinal:
+	default:
+		return nil, errors.Wrapf(ErrUnknownRankingMode, "%q", c.mode)
+	}
+	sortedTokenRarities := c.sortTokenRarities(tokenRarities)
+	rankedTokenRarities := make([]models.ITokenRarity, 0, len(sortedTokenRarities))
+	var denseRank int
+	for groupStart := 0; groupStart < len(sortedTokenRarities); {
+		groupEnd := groupStart + 1
+		for groupEnd < len(sortedTokenRarities) &&
+			c.isTied(sortedTokenRarities[groupEnd], sortedTokenRarities[groupEnd-1]) {
+			groupEnd++
 		}
-		return tokenRarities[i].Score() > tokenRarities[j].Score()
-	})
-	for i, tokenRarity := range tokenRarities {
-		rank := i + 1
-		if i > 0 {
-			prevTokenRarity := tokenRarities[i-1]
-			scoresEqual := IsFloat64Close(tokenRarity.Score(), prevTokenRarity.Score())
-			if scoresEqual {
-				if prevTokenRarity.Rank() == 0 {
-					return nil, errors.New("preview token rarity rank is zero")
-				}
-				rank = prevTokenRarity.Rank()
+		// tied tokens are ordered by their identifiers, so that the output doesn't depend on the input order
+		group := sortedTokenRarities[groupStart:groupEnd]
+		sort.SliceStable(group, func(i, j int) bool {
+			return models.CompareTokenIdentifiers(
+				group[i].tokenRarity.Token().TokenIdentifier(),
+				group[j].tokenRarity.Token().TokenIdentifier(),
+			) < 0
+		})
+		denseRank++
+		for idx, tokenRarity := range group {
+			rank := groupStart + 1
+			switch c.mode {
+			case RankingModeDenseRank:
+				rank = denseRank
+			case RankingModeOrdinal:
+				rank = groupStart + idx + 1
+			}
+			tokenRarity.tokenRarity.SetRarityRanks(rank)
+			rankedTokenRarities = append(rankedTokenRarities, tokenRarity.tokenRarity)
+		}
+		groupStart = groupEnd
+	}
+	return rankedTokenRarities, nil
+}
+
+// sortableTokenRarity holds a token rarity with the values of all its sort keys
+type sortableTokenRarity struct {
+	tokenRarity models.ITokenRarity
+	values      []float64
+}
+
+// sortTokenRarities is used to sort token rarities by the sort keys of the ranker,
+// without modifying the order of the given slice.
+func (c *RarityRanker) sortTokenRarities(tokenRarities []models.ITokenRarity) []*sortableTokenRarity {
+	sortedTokenRarities := make([]*sortableTokenRarity, 0, len(tokenRarities))
+	for _, tokenRarity := range tokenRarities {
+		values := make([]float64, 0, len(c.sortKeys))
+		for _, sortKey := range c.sortKeys {
+			values = append(values, sortKey.Value(tokenRarity))
+		}
+		sortedTokenRarities = append(sortedTokenRarities, &sortableTokenRarity{
+			tokenRarity: tokenRarity,
+			values:      values,
+		})
+	}
+	sort.SliceStable(sortedTokenRarities, func(i, j int) bool {
+		for idx, sortKey := range c.sortKeys {
+			a, b := sortedTokenRarities[i].values[idx], sortedTokenRarities[j].values[idx]
+			if a == b {
+				continue
+			}
+			if sortKey.Descending {
+				return a > b
 			}
+			return a < b
+		}
+		return false
+	})
+	return sortedTokenRarities
+}
+
+// isTied is used to judge whether two token rarities are close enough on every sort key,
+// not only on the score, so that tied tokens are never ordered by another sort key.
+func (c *RarityRanker) isTied(a, b *sortableTokenRarity) bool {
+	for idx := range c.sortKeys {
+		if !IsFloat64CloseWithTolerance(a.values[idx], b.values[idx], c.relTolerance, c.absTolerance) {
+			return false
 		}
-		tokenRarity.SetRarityRanks(rank)
 	}
-	return tokenRarities, nil
+	return true
 }
 
 // IsFloat64Close is used to judge whether two float64 are close enough
-// It tries to be equivalent to the performance of math.isclose in python
-// under the default parameters.
-// todo: more precise implementation
+// It is equivalent to math.isclose in python under the default parameters.
 func IsFloat64Close(a, b float64) bool {
-	return (a == b) || (math.Abs(a-b) <= 1e-9)
+	return IsFloat64CloseWithTolerance(a, b, DefaultRelTolerance, DefaultAbsTolerance)
+}
+
+// IsFloat64CloseWithTolerance is used to judge whether two float64 are close enough
+// It is equivalent to math.isclose in python, the difference between a and b must
+// not exceed the relative tolerance scaled by the larger magnitude of a and b, or
+// the absolute tolerance.
+func IsFloat64CloseWithTolerance(a, b, relTolerance, absTolerance float64) bool {
+	if a == b {
+		return true
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+	diff := math.Abs(a - b)
+	return diff <= math.Abs(relTolerance*b) ||
+		diff <= math.Abs(relTolerance*a) ||
+		diff <= absTolerance
 }
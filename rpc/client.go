@@ -0,0 +1,215 @@
+package rpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/rpc/openrarityv1"
+	"github.com/Base-Labs/openrarity/scoring"
+	"google.golang.org/grpc"
+)
+
+// DefaultChunkSize is the number of tokens sent per message by RankCollectionStream
+const DefaultChunkSize = 1000
+
+// Client is used to score, rank and explain collections with a remote RarityService. The bucket
+// labels of numeric and date attributes are not sent, the service buckets them again with its
+// own collection options.
+type Client struct {
+	client openrarityv1.RarityServiceClient
+}
+
+// NewClient is the constructor of Client
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		client: openrarityv1.NewRarityServiceClient(conn),
+	}
+}
+
+// RequestOption is used to configure how a request is scored and ranked
+type RequestOption func(c *requestConfig)
+
+type requestConfig struct {
+	handler   string
+	mode      openrarity.RankingMode
+	chunkSize int
+}
+
+func newRequestConfig(opts []RequestOption) *requestConfig {
+	c := &requestConfig{
+		chunkSize: DefaultChunkSize,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithHandler is used to score tokens with the score handler registered under the given name
+func WithHandler(name string) RequestOption {
+	return func(c *requestConfig) {
+		c.handler = name
+	}
+}
+
+// WithRankingMode is used to set the ranking mode of tied tokens
+func WithRankingMode(mode openrarity.RankingMode) RequestOption {
+	return func(c *requestConfig) {
+		c.mode = mode
+	}
+}
+
+// WithChunkSize is used to set the number of tokens sent per message by RankCollectionStream
+func WithChunkSize(size int) RequestOption {
+	return func(c *requestConfig) {
+		if size > 0 {
+			c.chunkSize = size
+		}
+	}
+}
+
+// ScoreCollection is used to score every token of a collection, scores are in the same order
+// as the tokens of the collection.
+func (c *Client) ScoreCollection(
+	ctx context.Context,
+	collection models.ICollection,
+	opts ...RequestOption,
+) ([]float64, error) {
+	config := newRequestConfig(opts)
+	message, err := CollectionToProto(collection)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.client.ScoreCollection(ctx, &openrarityv1.ScoreCollectionRequest{
+		Collection: message,
+		Handler:    config.handler,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetScores(), nil
+}
+
+// RankCollection is used to score and rank every token of a collection, the ranked tokens are
+// sorted by rank.
+func (c *Client) RankCollection(
+	ctx context.Context,
+	collection models.ICollection,
+	opts ...RequestOption,
+) ([]models.ITokenRarity, error) {
+	config := newRequestConfig(opts)
+	mode, err := RankingModeToProto(config.mode)
+	if err != nil {
+		return nil, err
+	}
+	message, err := CollectionToProto(collection)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.client.RankCollection(ctx, &openrarityv1.RankCollectionRequest{
+		Collection: message,
+		Handler:    config.handler,
+		Mode:       mode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tokenRarities := make([]models.ITokenRarity, 0, len(response.GetTokenRarities()))
+	for _, message := range response.GetTokenRarities() {
+		tokenRarity, err := TokenRarityFromProto(message)
+		if err != nil {
+			return nil, err
+		}
+		tokenRarities = append(tokenRarities, tokenRarity)
+	}
+	return tokenRarities, nil
+}
+
+// RankCollectionStream is the same as RankCollection, but the tokens of the collection are sent
+// in chunks of WithChunkSize tokens, and the ranked tokens are received one by one.
+func (c *Client) RankCollectionStream(
+	ctx context.Context,
+	collection models.ICollection,
+	opts ...RequestOption,
+) ([]models.ITokenRarity, error) {
+	config := newRequestConfig(opts)
+	mode, err := RankingModeToProto(config.mode)
+	if err != nil {
+		return nil, err
+	}
+	message, err := CollectionToProto(collection)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.client.RankCollectionStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request := &openrarityv1.RankCollectionStreamRequest{
+		Name:    message.GetName(),
+		Handler: config.handler,
+		Mode:    mode,
+	}
+	tokens, supplies := message.GetTokens(), message.GetSupplies()
+	for start := 0; start == 0 || start < len(tokens); start += config.chunkSize {
+		end := start + config.chunkSize
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+		request.Tokens = tokens[start:end]
+		if len(supplies) > 0 {
+			request.Supplies = supplies[start:end]
+		}
+		if err := stream.Send(request); err != nil {
+			return nil, err
+		}
+		request = &openrarityv1.RankCollectionStreamRequest{}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	tokenRarities := make([]models.ITokenRarity, 0, len(tokens))
+	for {
+		message, err := stream.Recv()
+		if err == io.EOF {
+			return tokenRarities, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		tokenRarity, err := TokenRarityFromProto(message)
+		if err != nil {
+			return nil, err
+		}
+		tokenRarities = append(tokenRarities, tokenRarity)
+	}
+}
+
+// ExplainToken is used to break down the score of a token of a collection
+func (c *Client) ExplainToken(
+	ctx context.Context,
+	collection models.ICollection,
+	token models.IToken,
+	opts ...RequestOption,
+) (*scoring.TokenExplanation, error) {
+	config := newRequestConfig(opts)
+	message, err := CollectionToProto(collection)
+	if err != nil {
+		return nil, err
+	}
+	identifier, err := TokenIdentifierToProto(token.TokenIdentifier())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.client.ExplainToken(ctx, &openrarityv1.ExplainTokenRequest{
+		Collection:      message,
+		TokenIdentifier: identifier,
+		Handler:         config.handler,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return TokenExplanationFromProto(response, token), nil
+}
@@ -0,0 +1,425 @@
+package rpc
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/rpc/openrarityv1"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidMessage is returned when a protobuf message can't be converted to its model
+var ErrInvalidMessage = errors.New("invalid message")
+
+// TokenIdentifierToProto is used to convert a token identifier to its protobuf message
+func TokenIdentifierToProto(identifier models.ITokenIdentifier) (*openrarityv1.TokenIdentifier, error) {
+	message := &openrarityv1.TokenIdentifier{}
+	switch identifier.IdentifierType() {
+	case models.IdentifierTypeEVMContract:
+		v, ok := identifier.(interface {
+			ContractAddress() string
+			TokenID() *big.Int
+		})
+		if ok {
+			message.Identifier = &openrarityv1.TokenIdentifier_EvmContract{
+				EvmContract: &openrarityv1.EVMContractTokenIdentifier{
+					ContractAddress: v.ContractAddress(),
+					TokenId:         v.TokenID().String(),
+				},
+			}
+			return message, nil
+		}
+	case models.IdentifierTypeSolanaMintAddress:
+		if v, ok := identifier.(interface{ MintAddress() string }); ok {
+			message.Identifier = &openrarityv1.TokenIdentifier_SolanaMintAddress{
+				SolanaMintAddress: &openrarityv1.SolanaMintAddressTokenIdentifier{
+					MintAddress: v.MintAddress(),
+				},
+			}
+			return message, nil
+		}
+	case models.IdentifierTypeTezosFA2:
+		v, ok := identifier.(interface {
+			ContractAddress() string
+			TokenID() *big.Int
+		})
+		if ok {
+			message.Identifier = &openrarityv1.TokenIdentifier_TezosFa2{
+				TezosFa2: &openrarityv1.TezosFA2TokenIdentifier{
+					ContractAddress: v.ContractAddress(),
+					TokenId:         v.TokenID().String(),
+				},
+			}
+			return message, nil
+		}
+	case models.IdentifierTypeOrdinalsInscription:
+		if v, ok := identifier.(interface{ InscriptionID() string }); ok {
+			message.Identifier = &openrarityv1.TokenIdentifier_OrdinalsInscription{
+				OrdinalsInscription: &openrarityv1.OrdinalsInscriptionTokenIdentifier{
+					InscriptionId: v.InscriptionID(),
+				},
+			}
+			return message, nil
+		}
+	case models.IdentifierTypeAptosObject:
+		if v, ok := identifier.(interface{ ObjectID() string }); ok {
+			message.Identifier = &openrarityv1.TokenIdentifier_AptosObject{
+				AptosObject: &openrarityv1.MoveObjectTokenIdentifier{ObjectId: v.ObjectID()},
+			}
+			return message, nil
+		}
+	case models.IdentifierTypeSuiObject:
+		if v, ok := identifier.(interface{ ObjectID() string }); ok {
+			message.Identifier = &openrarityv1.TokenIdentifier_SuiObject{
+				SuiObject: &openrarityv1.MoveObjectTokenIdentifier{ObjectId: v.ObjectID()},
+			}
+			return message, nil
+		}
+	case models.IdentifierTypeCosmosCW721:
+		v, ok := identifier.(interface {
+			ContractAddress() string
+			TokenID() string
+		})
+		if ok {
+			message.Identifier = &openrarityv1.TokenIdentifier_CosmosCw721{
+				CosmosCw721: &openrarityv1.CosmosCW721TokenIdentifier{
+					ContractAddress: v.ContractAddress(),
+					TokenId:         v.TokenID(),
+				},
+			}
+			return message, nil
+		}
+	}
+	return nil, errors.Wrapf(models.ErrInvalidTokenIdentifier, "unsupported identifier %s", identifier)
+}
+
+// TokenIdentifierFromProto is used to convert a protobuf message to a token identifier, validating
+// its fields like the constructor of the identifier type does.
+func TokenIdentifierFromProto(message *openrarityv1.TokenIdentifier) (models.ITokenIdentifier, error) {
+	switch v := message.GetIdentifier().(type) {
+	case *openrarityv1.TokenIdentifier_EvmContract:
+		return models.ParseEVMContractTokenIdentifier(v.EvmContract.GetContractAddress(), v.EvmContract.GetTokenId())
+	case *openrarityv1.TokenIdentifier_SolanaMintAddress:
+		if v.SolanaMintAddress.GetMintAddress() == "" {
+			return nil, errors.Wrap(models.ErrInvalidTokenIdentifier, "empty mint address")
+		}
+		return models.NewSolanaMintAddressTokenIdentifier(v.SolanaMintAddress.GetMintAddress()), nil
+	case *openrarityv1.TokenIdentifier_TezosFa2:
+		return models.ParseTezosFA2TokenIdentifier(v.TezosFa2.GetContractAddress(), v.TezosFa2.GetTokenId())
+	case *openrarityv1.TokenIdentifier_OrdinalsInscription:
+		return models.NewOrdinalsInscriptionTokenIdentifier(v.OrdinalsInscription.GetInscriptionId())
+	case *openrarityv1.TokenIdentifier_AptosObject:
+		return models.NewAptosObjectTokenIdentifier(v.AptosObject.GetObjectId())
+	case *openrarityv1.TokenIdentifier_SuiObject:
+		return models.NewSuiObjectTokenIdentifier(v.SuiObject.GetObjectId())
+	case *openrarityv1.TokenIdentifier_CosmosCw721:
+		return models.NewCosmosCW721TokenIdentifier(v.CosmosCw721.GetContractAddress(), v.CosmosCw721.GetTokenId())
+	default:
+		return nil, errors.Wrap(models.ErrInvalidTokenIdentifier, "missing identifier")
+	}
+}
+
+// TokenMetadataToProto is used to convert token metadata to its protobuf message. The string
+// attributes holding the bucket label of a numeric or date attribute are left out, so that the
+// receiver buckets the attribute again, see WithCollectionOptions.
+func TokenMetadataToProto(metadata models.ITokenMetadata) *openrarityv1.TokenMetadata {
+	numericAttributes, dateAttributes := metadata.NumericAttributes(), metadata.DateAttributes()
+	message := &openrarityv1.TokenMetadata{
+		StringAttributes:  make(map[string]string, len(metadata.StringAttributes())),
+		NumericAttributes: make(map[string]*openrarityv1.NumericValue, len(numericAttributes)),
+		DateAttributes:    make(map[string]int64, len(dateAttributes)),
+	}
+	for name, attribute := range metadata.StringAttributes() {
+		if _, binned := numericAttributes[name]; binned {
+			continue
+		}
+		if _, binned := dateAttributes[name]; binned {
+			continue
+		}
+		message.StringAttributes[name] = attribute.Value()
+	}
+	for name, attribute := range numericAttributes {
+		value := &openrarityv1.NumericValue{}
+		if v, ok := attribute.Value().Int64(); ok {
+			value.Value = &openrarityv1.NumericValue_IntValue{IntValue: v}
+		} else {
+			v, _ := attribute.Value().Float64()
+			value.Value = &openrarityv1.NumericValue_FloatValue{FloatValue: v}
+		}
+		message.NumericAttributes[name] = value
+	}
+	for name, attribute := range dateAttributes {
+		message.DateAttributes[name] = attribute.Value()
+	}
+	return message
+}
+
+// TokenMetadataFromProto is used to convert a protobuf message to token metadata, attribute names
+// must be unique across the string, numeric and date attributes once normalized.
+func TokenMetadataFromProto(message *openrarityv1.TokenMetadata) (*models.TokenMetadata, error) {
+	attributes := make(map[string]interface{},
+		len(message.GetStringAttributes())+len(message.GetNumericAttributes())+len(message.GetDateAttributes()),
+	)
+	names := map[string]struct{}{}
+	addAttribute := func(name string, value interface{}) error {
+		normalizedName := models.NormalizeAttributeString(name)
+		if _, exists := names[normalizedName]; exists {
+			return errors.Wrapf(ErrInvalidMessage, "duplicate attribute %q", normalizedName)
+		}
+		names[normalizedName] = struct{}{}
+		attributes[name] = value
+		return nil
+	}
+	for name, value := range message.GetStringAttributes() {
+		if err := addAttribute(name, value); err != nil {
+			return nil, err
+		}
+	}
+	for name, value := range message.GetNumericAttributes() {
+		var number interface{}
+		switch v := value.GetValue().(type) {
+		case *openrarityv1.NumericValue_IntValue:
+			number = v.IntValue
+		case *openrarityv1.NumericValue_FloatValue:
+			number = v.FloatValue
+		default:
+			return nil, errors.Wrapf(ErrInvalidMessage, "numeric attribute %q has no value", name)
+		}
+		if err := addAttribute(name, number); err != nil {
+			return nil, err
+		}
+	}
+	for name, value := range message.GetDateAttributes() {
+		if err := addAttribute(name, time.Unix(value, 0)); err != nil {
+			return nil, err
+		}
+	}
+	return models.NewTokenMetadataFromAttributes(attributes)
+}
+
+// TokenToProto is used to convert a token to its protobuf message
+func TokenToProto(token models.IToken) (*openrarityv1.Token, error) {
+	identifier, err := TokenIdentifierToProto(token.TokenIdentifier())
+	if err != nil {
+		return nil, err
+	}
+	return &openrarityv1.Token{
+		TokenIdentifier: identifier,
+		TokenStandard:   string(token.TokenStandard()),
+		Metadata:        TokenMetadataToProto(token.Metadata()),
+	}, nil
+}
+
+// TokenFromProto is used to convert a protobuf message to a token
+func TokenFromProto(message *openrarityv1.Token) (*models.Token, error) {
+	identifier, err := TokenIdentifierFromProto(message.GetTokenIdentifier())
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := TokenMetadataFromProto(message.GetMetadata())
+	if err != nil {
+		return nil, errors.Wrapf(err, "token %s", identifier)
+	}
+	return models.NewToken(identifier, models.TokenStandard(message.GetTokenStandard()), metadata), nil
+}
+
+// CollectionToProto is used to convert a collection to its protobuf message, with the token
+// supplies of ERC1155 collections.
+func CollectionToProto(collection models.ICollection) (*openrarityv1.Collection, error) {
+	message := &openrarityv1.Collection{}
+	if v, ok := collection.(interface{ Name() string }); ok {
+		message.Name = v.Name()
+	}
+	tokens, err := tokensToProto(collection.Tokens())
+	if err != nil {
+		return nil, err
+	}
+	message.Tokens = tokens
+	if v, ok := collection.(models.IERC1155Collection); ok {
+		message.Supplies = suppliesToProto(v.TokenSupplies())
+	}
+	return message, nil
+}
+
+// CollectionFromProto is used to convert a protobuf message to a collection, which is an
+// ERC1155 collection if the message holds token supplies.
+func CollectionFromProto(
+	message *openrarityv1.Collection,
+	opts ...models.CollectionOption,
+) (models.ICollection, error) {
+	return newCollection(message.GetName(), message.GetTokens(), message.GetSupplies(), opts)
+}
+
+func newCollection(
+	name string,
+	messages []*openrarityv1.Token,
+	supplies []int64,
+	opts []models.CollectionOption,
+) (models.ICollection, error) {
+	tokens := make([]models.IToken, 0, len(messages))
+	for idx, message := range messages {
+		token, err := TokenFromProto(message)
+		if err != nil {
+			return nil, errors.Wrapf(err, "token at index %d", idx)
+		}
+		tokens = append(tokens, token)
+	}
+	if len(supplies) == 0 {
+		return models.NewCollection(name, tokens, opts...)
+	}
+	tokenSupplies := make([]int, 0, len(supplies))
+	for _, supply := range supplies {
+		tokenSupplies = append(tokenSupplies, int(supply))
+	}
+	collection, err := models.NewERC1155Collection(name, tokens, tokenSupplies, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidMessage, "%v", err)
+	}
+	return collection, nil
+}
+
+func tokensToProto(tokens []models.IToken) ([]*openrarityv1.Token, error) {
+	messages := make([]*openrarityv1.Token, 0, len(tokens))
+	for _, token := range tokens {
+		message, err := TokenToProto(token)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func suppliesToProto(supplies []int) []int64 {
+	messages := make([]int64, 0, len(supplies))
+	for _, supply := range supplies {
+		messages = append(messages, int64(supply))
+	}
+	return messages
+}
+
+// TokenRarityToProto is used to convert a ranked token to its protobuf message
+func TokenRarityToProto(tokenRarity models.ITokenRarity) (*openrarityv1.TokenRarity, error) {
+	token, err := TokenToProto(tokenRarity.Token())
+	if err != nil {
+		return nil, err
+	}
+	message := &openrarityv1.TokenRarity{
+		Token: token,
+		Score: tokenRarity.Score(),
+		Rank:  int64(tokenRarity.Rank()),
+	}
+	if features := tokenRarity.TokenFeatures(); features != nil {
+		// the message must not share the map of features of the ranked token
+		values := make(map[string]float64, len(features.Features()))
+		for name, value := range features.Features() {
+			values[name] = value
+		}
+		message.Features = &openrarityv1.TokenRankingFeatures{
+			UniqueAttributeCount: int64(features.UniqueAttributeCount()),
+			Features:             values,
+		}
+	}
+	return message, nil
+}
+
+// TokenRarityFromProto is used to convert a protobuf message to a ranked token
+func TokenRarityFromProto(message *openrarityv1.TokenRarity) (*models.TokenRarity, error) {
+	token, err := TokenFromProto(message.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	features := make(map[string]float64, len(message.GetFeatures().GetFeatures())+1)
+	for name, value := range message.GetFeatures().GetFeatures() {
+		features[name] = value
+	}
+	features[models.UniqueAttributeCountFeatureName] = float64(message.GetFeatures().GetUniqueAttributeCount())
+	tokenRarity := models.NewTokenRarity(token, message.GetScore(), models.NewTokenRankingFeaturesFromMap(features))
+	tokenRarity.SetRarityRanks(int(message.GetRank()))
+	return tokenRarity, nil
+}
+
+// RankingModeToProto is used to convert a ranking mode to its protobuf enum
+func RankingModeToProto(mode openrarity.RankingMode) (openrarityv1.RankingMode, error) {
+	switch mode {
+	case "":
+		return openrarityv1.RankingMode_RANKING_MODE_UNSPECIFIED, nil
+	case openrarity.RankingModeRank:
+		return openrarityv1.RankingMode_RANKING_MODE_RANK, nil
+	case openrarity.RankingModeDenseRank:
+		return openrarityv1.RankingMode_RANKING_MODE_DENSE_RANK, nil
+	case openrarity.RankingModeOrdinal:
+		return openrarityv1.RankingMode_RANKING_MODE_ORDINAL, nil
+	default:
+		return 0, errors.Wrapf(openrarity.ErrUnknownRankingMode, "%q", mode)
+	}
+}
+
+// RankingModeFromProto is used to convert a protobuf enum to a ranking mode
+func RankingModeFromProto(mode openrarityv1.RankingMode) (openrarity.RankingMode, error) {
+	switch mode {
+	case openrarityv1.RankingMode_RANKING_MODE_UNSPECIFIED, openrarityv1.RankingMode_RANKING_MODE_RANK:
+		return openrarity.RankingModeRank, nil
+	case openrarityv1.RankingMode_RANKING_MODE_DENSE_RANK:
+		return openrarity.RankingModeDenseRank, nil
+	case openrarityv1.RankingMode_RANKING_MODE_ORDINAL:
+		return openrarity.RankingModeOrdinal, nil
+	default:
+		return "", errors.Wrapf(openrarity.ErrUnknownRankingMode, "%v", mode)
+	}
+}
+
+// TokenExplanationToProto is used to convert the explanation of a token score to its protobuf message
+func TokenExplanationToProto(explanation *scoring.TokenExplanation) (*openrarityv1.ExplainTokenResponse, error) {
+	identifier, err := TokenIdentifierToProto(explanation.Token.TokenIdentifier())
+	if err != nil {
+		return nil, err
+	}
+	message := &openrarityv1.ExplainTokenResponse{
+		TokenIdentifier:    identifier,
+		Attributes:         make([]*openrarityv1.AttributeExplanation, 0, len(explanation.Attributes)),
+		InformationContent: explanation.InformationContent,
+		EntropyNormalizer:  explanation.EntropyNormalizer,
+		Score:              explanation.Score,
+	}
+	for _, attribute := range explanation.Attributes {
+		message.Attributes = append(message.Attributes, &openrarityv1.AttributeExplanation{
+			Name:               attribute.Name,
+			Value:              attribute.Value,
+			TotalTokens:        int64(attribute.TotalTokens),
+			Probability:        attribute.Probability,
+			InformationContent: attribute.InformationContent,
+			IsNull:             attribute.IsNull,
+		})
+	}
+	return message, nil
+}
+
+// TokenExplanationFromProto is used to convert a protobuf message to the explanation of the
+// score of the given token.
+func TokenExplanationFromProto(
+	message *openrarityv1.ExplainTokenResponse,
+	token models.IToken,
+) *scoring.TokenExplanation {
+	explanation := &scoring.TokenExplanation{
+		Token:              token,
+		Attributes:         make([]*scoring.AttributeExplanation, 0, len(message.GetAttributes())),
+		InformationContent: message.GetInformationContent(),
+		EntropyNormalizer:  message.GetEntropyNormalizer(),
+		Score:              message.GetScore(),
+	}
+	for _, attribute := range message.GetAttributes() {
+		explanation.Attributes = append(explanation.Attributes, &scoring.AttributeExplanation{
+			Name:               attribute.GetName(),
+			Value:              attribute.GetValue(),
+			TotalTokens:        int(attribute.GetTotalTokens()),
+			Probability:        attribute.GetProbability(),
+			InformationContent: attribute.GetInformationContent(),
+			IsNull:             attribute.GetIsNull(),
+		})
+	}
+	return explanation
+}
@@ -0,0 +1,2138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: openrarity/v1/openrarity.proto
+
+package openrarityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RankingMode defines how ranks are assigned to tokens with the same score.
+type RankingMode int32
+
+const (
+	// RANKING_MODE_UNSPECIFIED is the same as RANKING_MODE_RANK.
+	RankingMode_RANKING_MODE_UNSPECIFIED RankingMode = 0
+	// RANKING_MODE_RANK assigns the same rank to tied tokens and skips the following ranks.
+	RankingMode_RANKING_MODE_RANK RankingMode = 1
+	// RANKING_MODE_DENSE_RANK assigns the same rank to tied tokens without skipping ranks.
+	RankingMode_RANKING_MODE_DENSE_RANK RankingMode = 2
+	// RANKING_MODE_ORDINAL assigns distinct ranks to all tokens.
+	RankingMode_RANKING_MODE_ORDINAL RankingMode = 3
+)
+
+// Enum value maps for RankingMode.
+var (
+	RankingMode_name = map[int32]string{
+		0: "RANKING_MODE_UNSPECIFIED",
+		1: "RANKING_MODE_RANK",
+		2: "RANKING_MODE_DENSE_RANK",
+		3: "RANKING_MODE_ORDINAL",
+	}
+	RankingMode_value = map[string]int32{
+		"RANKING_MODE_UNSPECIFIED": 0,
+		"RANKING_MODE_RANK":        1,
+		"RANKING_MODE_DENSE_RANK":  2,
+		"RANKING_MODE_ORDINAL":     3,
+	}
+)
+
+func (x RankingMode) Enum() *RankingMode {
+	p := new(RankingMode)
+	*p = x
+	return p
+}
+
+func (x RankingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RankingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_openrarity_v1_openrarity_proto_enumTypes[0].Descriptor()
+}
+
+func (RankingMode) Type() protoreflect.EnumType {
+	return &file_openrarity_v1_openrarity_proto_enumTypes[0]
+}
+
+func (x RankingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RankingMode.Descriptor instead.
+func (RankingMode) EnumDescriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{0}
+}
+
+// EVMContractTokenIdentifier identifies a token by its contract address and token ID.
+type EVMContractTokenIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// token_id is an unsigned 256-bit integer written in decimal.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *EVMContractTokenIdentifier) Reset() {
+	*x = EVMContractTokenIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMContractTokenIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMContractTokenIdentifier) ProtoMessage() {}
+
+func (x *EVMContractTokenIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMContractTokenIdentifier.ProtoReflect.Descriptor instead.
+func (*EVMContractTokenIdentifier) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EVMContractTokenIdentifier) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *EVMContractTokenIdentifier) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// SolanaMintAddressTokenIdentifier identifies a token by its mint address.
+type SolanaMintAddressTokenIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MintAddress string `protobuf:"bytes,1,opt,name=mint_address,json=mintAddress,proto3" json:"mint_address,omitempty"`
+}
+
+func (x *SolanaMintAddressTokenIdentifier) Reset() {
+	*x = SolanaMintAddressTokenIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolanaMintAddressTokenIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolanaMintAddressTokenIdentifier) ProtoMessage() {}
+
+func (x *SolanaMintAddressTokenIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolanaMintAddressTokenIdentifier.ProtoReflect.Descriptor instead.
+func (*SolanaMintAddressTokenIdentifier) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SolanaMintAddressTokenIdentifier) GetMintAddress() string {
+	if x != nil {
+		return x.MintAddress
+	}
+	return ""
+}
+
+// TezosFA2TokenIdentifier identifies a token by its KT1 contract address and token ID.
+type TezosFA2TokenIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// token_id is a natural number written in decimal.
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *TezosFA2TokenIdentifier) Reset() {
+	*x = TezosFA2TokenIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TezosFA2TokenIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TezosFA2TokenIdentifier) ProtoMessage() {}
+
+func (x *TezosFA2TokenIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TezosFA2TokenIdentifier.ProtoReflect.Descriptor instead.
+func (*TezosFA2TokenIdentifier) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TezosFA2TokenIdentifier) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TezosFA2TokenIdentifier) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// OrdinalsInscriptionTokenIdentifier identifies a Bitcoin inscription, e.g. <txid>i0.
+type OrdinalsInscriptionTokenIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InscriptionId string `protobuf:"bytes,1,opt,name=inscription_id,json=inscriptionId,proto3" json:"inscription_id,omitempty"`
+}
+
+func (x *OrdinalsInscriptionTokenIdentifier) Reset() {
+	*x = OrdinalsInscriptionTokenIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrdinalsInscriptionTokenIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdinalsInscriptionTokenIdentifier) ProtoMessage() {}
+
+func (x *OrdinalsInscriptionTokenIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdinalsInscriptionTokenIdentifier.ProtoReflect.Descriptor instead.
+func (*OrdinalsInscriptionTokenIdentifier) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrdinalsInscriptionTokenIdentifier) GetInscriptionId() string {
+	if x != nil {
+		return x.InscriptionId
+	}
+	return ""
+}
+
+// MoveObjectTokenIdentifier identifies an Aptos or Sui token by its object ID.
+type MoveObjectTokenIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectId string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+}
+
+func (x *MoveObjectTokenIdentifier) Reset() {
+	*x = MoveObjectTokenIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveObjectTokenIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveObjectTokenIdentifier) ProtoMessage() {}
+
+func (x *MoveObjectTokenIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveObjectTokenIdentifier.ProtoReflect.Descriptor instead.
+func (*MoveObjectTokenIdentifier) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MoveObjectTokenIdentifier) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+// CosmosCW721TokenIdentifier identifies a CW721 token by its bech32 contract address and token ID.
+type CosmosCW721TokenIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *CosmosCW721TokenIdentifier) Reset() {
+	*x = CosmosCW721TokenIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosCW721TokenIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosCW721TokenIdentifier) ProtoMessage() {}
+
+func (x *CosmosCW721TokenIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosCW721TokenIdentifier.ProtoReflect.Descriptor instead.
+func (*CosmosCW721TokenIdentifier) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CosmosCW721TokenIdentifier) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *CosmosCW721TokenIdentifier) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// TokenIdentifier identifies a token of any of the supported chains.
+type TokenIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Identifier:
+	//	*TokenIdentifier_EvmContract
+	//	*TokenIdentifier_SolanaMintAddress
+	//	*TokenIdentifier_TezosFa2
+	//	*TokenIdentifier_OrdinalsInscription
+	//	*TokenIdentifier_AptosObject
+	//	*TokenIdentifier_SuiObject
+	//	*TokenIdentifier_CosmosCw721
+	Identifier isTokenIdentifier_Identifier `protobuf_oneof:"identifier"`
+}
+
+func (x *TokenIdentifier) Reset() {
+	*x = TokenIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenIdentifier) ProtoMessage() {}
+
+func (x *TokenIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenIdentifier.ProtoReflect.Descriptor instead.
+func (*TokenIdentifier) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *TokenIdentifier) GetIdentifier() isTokenIdentifier_Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (x *TokenIdentifier) GetEvmContract() *EVMContractTokenIdentifier {
+	if x, ok := x.GetIdentifier().(*TokenIdentifier_EvmContract); ok {
+		return x.EvmContract
+	}
+	return nil
+}
+
+func (x *TokenIdentifier) GetSolanaMintAddress() *SolanaMintAddressTokenIdentifier {
+	if x, ok := x.GetIdentifier().(*TokenIdentifier_SolanaMintAddress); ok {
+		return x.SolanaMintAddress
+	}
+	return nil
+}
+
+func (x *TokenIdentifier) GetTezosFa2() *TezosFA2TokenIdentifier {
+	if x, ok := x.GetIdentifier().(*TokenIdentifier_TezosFa2); ok {
+		return x.TezosFa2
+	}
+	return nil
+}
+
+func (x *TokenIdentifier) GetOrdinalsInscription() *OrdinalsInscriptionTokenIdentifier {
+	if x, ok := x.GetIdentifier().(*TokenIdentifier_OrdinalsInscription); ok {
+		return x.OrdinalsInscription
+	}
+	return nil
+}
+
+func (x *TokenIdentifier) GetAptosObject() *MoveObjectTokenIdentifier {
+	if x, ok := x.GetIdentifier().(*TokenIdentifier_AptosObject); ok {
+		return x.AptosObject
+	}
+	return nil
+}
+
+func (x *TokenIdentifier) GetSuiObject() *MoveObjectTokenIdentifier {
+	if x, ok := x.GetIdentifier().(*TokenIdentifier_SuiObject); ok {
+		return x.SuiObject
+	}
+	return nil
+}
+
+func (x *TokenIdentifier) GetCosmosCw721() *CosmosCW721TokenIdentifier {
+	if x, ok := x.GetIdentifier().(*TokenIdentifier_CosmosCw721); ok {
+		return x.CosmosCw721
+	}
+	return nil
+}
+
+type isTokenIdentifier_Identifier interface {
+	isTokenIdentifier_Identifier()
+}
+
+type TokenIdentifier_EvmContract struct {
+	EvmContract *EVMContractTokenIdentifier `protobuf:"bytes,1,opt,name=evm_contract,json=evmContract,proto3,oneof"`
+}
+
+type TokenIdentifier_SolanaMintAddress struct {
+	SolanaMintAddress *SolanaMintAddressTokenIdentifier `protobuf:"bytes,2,opt,name=solana_mint_address,json=solanaMintAddress,proto3,oneof"`
+}
+
+type TokenIdentifier_TezosFa2 struct {
+	TezosFa2 *TezosFA2TokenIdentifier `protobuf:"bytes,3,opt,name=tezos_fa2,json=tezosFa2,proto3,oneof"`
+}
+
+type TokenIdentifier_OrdinalsInscription struct {
+	OrdinalsInscription *OrdinalsInscriptionTokenIdentifier `protobuf:"bytes,4,opt,name=ordinals_inscription,json=ordinalsInscription,proto3,oneof"`
+}
+
+type TokenIdentifier_AptosObject struct {
+	AptosObject *MoveObjectTokenIdentifier `protobuf:"bytes,5,opt,name=aptos_object,json=aptosObject,proto3,oneof"`
+}
+
+type TokenIdentifier_SuiObject struct {
+	SuiObject *MoveObjectTokenIdentifier `protobuf:"bytes,6,opt,name=sui_object,json=suiObject,proto3,oneof"`
+}
+
+type TokenIdentifier_CosmosCw721 struct {
+	CosmosCw721 *CosmosCW721TokenIdentifier `protobuf:"bytes,7,opt,name=cosmos_cw721,json=cosmosCw721,proto3,oneof"`
+}
+
+func (*TokenIdentifier_EvmContract) isTokenIdentifier_Identifier() {}
+
+func (*TokenIdentifier_SolanaMintAddress) isTokenIdentifier_Identifier() {}
+
+func (*TokenIdentifier_TezosFa2) isTokenIdentifier_Identifier() {}
+
+func (*TokenIdentifier_OrdinalsInscription) isTokenIdentifier_Identifier() {}
+
+func (*TokenIdentifier_AptosObject) isTokenIdentifier_Identifier() {}
+
+func (*TokenIdentifier_SuiObject) isTokenIdentifier_Identifier() {}
+
+func (*TokenIdentifier_CosmosCw721) isTokenIdentifier_Identifier() {}
+
+// NumericValue is the value of a numeric attribute, either an integer or a floating point number.
+type NumericValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*NumericValue_IntValue
+	//	*NumericValue_FloatValue
+	Value isNumericValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *NumericValue) Reset() {
+	*x = NumericValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumericValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericValue) ProtoMessage() {}
+
+func (x *NumericValue) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericValue.ProtoReflect.Descriptor instead.
+func (*NumericValue) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *NumericValue) GetValue() isNumericValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *NumericValue) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*NumericValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *NumericValue) GetFloatValue() float64 {
+	if x, ok := x.GetValue().(*NumericValue_FloatValue); ok {
+		return x.FloatValue
+	}
+	return 0
+}
+
+type isNumericValue_Value interface {
+	isNumericValue_Value()
+}
+
+type NumericValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,1,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type NumericValue_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,2,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+func (*NumericValue_IntValue) isNumericValue_Value() {}
+
+func (*NumericValue_FloatValue) isNumericValue_Value() {}
+
+// TokenMetadata holds the attributes of a token by attribute name.
+type TokenMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StringAttributes  map[string]string        `protobuf:"bytes,1,rep,name=string_attributes,json=stringAttributes,proto3" json:"string_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumericAttributes map[string]*NumericValue `protobuf:"bytes,2,rep,name=numeric_attributes,json=numericAttributes,proto3" json:"numeric_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// date_attributes holds unix timestamps in seconds.
+	DateAttributes map[string]int64 `protobuf:"bytes,3,rep,name=date_attributes,json=dateAttributes,proto3" json:"date_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *TokenMetadata) Reset() {
+	*x = TokenMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenMetadata) ProtoMessage() {}
+
+func (x *TokenMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenMetadata.ProtoReflect.Descriptor instead.
+func (*TokenMetadata) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TokenMetadata) GetStringAttributes() map[string]string {
+	if x != nil {
+		return x.StringAttributes
+	}
+	return nil
+}
+
+func (x *TokenMetadata) GetNumericAttributes() map[string]*NumericValue {
+	if x != nil {
+		return x.NumericAttributes
+	}
+	return nil
+}
+
+func (x *TokenMetadata) GetDateAttributes() map[string]int64 {
+	if x != nil {
+		return x.DateAttributes
+	}
+	return nil
+}
+
+// Token is a token of a collection.
+type Token struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenIdentifier *TokenIdentifier `protobuf:"bytes,1,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	// token_standard is the standard of the token, e.g. erc721 or metaplex_non_fungible.
+	TokenStandard string         `protobuf:"bytes,2,opt,name=token_standard,json=tokenStandard,proto3" json:"token_standard,omitempty"`
+	Metadata      *TokenMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Token) GetTokenIdentifier() *TokenIdentifier {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *Token) GetTokenStandard() string {
+	if x != nil {
+		return x.TokenStandard
+	}
+	return ""
+}
+
+func (x *Token) GetMetadata() *TokenMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Collection is a set of tokens scored together.
+type Collection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tokens []*Token `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// supplies holds the edition supply of every token of ERC1155 collections, in the same order
+	// as tokens. It is empty for other collections.
+	Supplies []int64 `protobuf:"varint,3,rep,packed,name=supplies,proto3" json:"supplies,omitempty"`
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *Collection) GetSupplies() []int64 {
+	if x != nil {
+		return x.Supplies
+	}
+	return nil
+}
+
+// TokenRankingFeatures holds the features used to rank a token.
+type TokenRankingFeatures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UniqueAttributeCount int64              `protobuf:"varint,1,opt,name=unique_attribute_count,json=uniqueAttributeCount,proto3" json:"unique_attribute_count,omitempty"`
+	Features             map[string]float64 `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *TokenRankingFeatures) Reset() {
+	*x = TokenRankingFeatures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenRankingFeatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRankingFeatures) ProtoMessage() {}
+
+func (x *TokenRankingFeatures) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRankingFeatures.ProtoReflect.Descriptor instead.
+func (*TokenRankingFeatures) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenRankingFeatures) GetUniqueAttributeCount() int64 {
+	if x != nil {
+		return x.UniqueAttributeCount
+	}
+	return 0
+}
+
+func (x *TokenRankingFeatures) GetFeatures() map[string]float64 {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// TokenRarity holds the score and the rank of a token.
+type TokenRarity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    *Token                `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Score    float64               `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Rank     int64                 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Features *TokenRankingFeatures `protobuf:"bytes,4,opt,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *TokenRarity) Reset() {
+	*x = TokenRarity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenRarity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRarity) ProtoMessage() {}
+
+func (x *TokenRarity) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRarity.ProtoReflect.Descriptor instead.
+func (*TokenRarity) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TokenRarity) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *TokenRarity) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TokenRarity) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TokenRarity) GetFeatures() *TokenRankingFeatures {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type ScoreCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// handler is the name of the score handler, the information content handler if empty.
+	Handler string `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+}
+
+func (x *ScoreCollectionRequest) Reset() {
+	*x = ScoreCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreCollectionRequest) ProtoMessage() {}
+
+func (x *ScoreCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ScoreCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ScoreCollectionRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *ScoreCollectionRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+type ScoreCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// scores holds the score of every token, in the same order as the tokens of the collection.
+	Scores []float64 `protobuf:"fixed64,1,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *ScoreCollectionResponse) Reset() {
+	*x = ScoreCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreCollectionResponse) ProtoMessage() {}
+
+func (x *ScoreCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ScoreCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScoreCollectionResponse) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type RankCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// handler is the name of the score handler, the information content handler if empty.
+	Handler string      `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+	Mode    RankingMode `protobuf:"varint,3,opt,name=mode,proto3,enum=openrarity.v1.RankingMode" json:"mode,omitempty"`
+}
+
+func (x *RankCollectionRequest) Reset() {
+	*x = RankCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankCollectionRequest) ProtoMessage() {}
+
+func (x *RankCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RankCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RankCollectionRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *RankCollectionRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *RankCollectionRequest) GetMode() RankingMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RankingMode_RANKING_MODE_UNSPECIFIED
+}
+
+type RankCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// token_rarities holds the ranked tokens, sorted by rank.
+	TokenRarities []*TokenRarity `protobuf:"bytes,1,rep,name=token_rarities,json=tokenRarities,proto3" json:"token_rarities,omitempty"`
+}
+
+func (x *RankCollectionResponse) Reset() {
+	*x = RankCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankCollectionResponse) ProtoMessage() {}
+
+func (x *RankCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RankCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RankCollectionResponse) GetTokenRarities() []*TokenRarity {
+	if x != nil {
+		return x.TokenRarities
+	}
+	return nil
+}
+
+type RankCollectionStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name, handler and mode are read from the first message only.
+	Name    string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Handler string      `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+	Mode    RankingMode `protobuf:"varint,3,opt,name=mode,proto3,enum=openrarity.v1.RankingMode" json:"mode,omitempty"`
+	// tokens holds the next chunk of tokens of the collection.
+	Tokens []*Token `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// supplies holds the edition supplies of the tokens of the chunk, for ERC1155 collections.
+	Supplies []int64 `protobuf:"varint,5,rep,packed,name=supplies,proto3" json:"supplies,omitempty"`
+}
+
+func (x *RankCollectionStreamRequest) Reset() {
+	*x = RankCollectionStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankCollectionStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankCollectionStreamRequest) ProtoMessage() {}
+
+func (x *RankCollectionStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankCollectionStreamRequest.ProtoReflect.Descriptor instead.
+func (*RankCollectionStreamRequest) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RankCollectionStreamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RankCollectionStreamRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *RankCollectionStreamRequest) GetMode() RankingMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RankingMode_RANKING_MODE_UNSPECIFIED
+}
+
+func (x *RankCollectionStreamRequest) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *RankCollectionStreamRequest) GetSupplies() []int64 {
+	if x != nil {
+		return x.Supplies
+	}
+	return nil
+}
+
+type ExplainTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection      *Collection      `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	TokenIdentifier *TokenIdentifier `protobuf:"bytes,2,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	// handler is the name of the score handler, the information content handler if empty.
+	Handler string `protobuf:"bytes,3,opt,name=handler,proto3" json:"handler,omitempty"`
+}
+
+func (x *ExplainTokenRequest) Reset() {
+	*x = ExplainTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainTokenRequest) ProtoMessage() {}
+
+func (x *ExplainTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainTokenRequest.ProtoReflect.Descriptor instead.
+func (*ExplainTokenRequest) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExplainTokenRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *ExplainTokenRequest) GetTokenIdentifier() *TokenIdentifier {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *ExplainTokenRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+// AttributeExplanation describes the contribution of an attribute to the score of a token.
+type AttributeExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// total_tokens is the number of tokens in the collection with the same value.
+	TotalTokens int64   `protobuf:"varint,3,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	Probability float64 `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"`
+	// information_content is the information content of the value, in bits.
+	InformationContent float64 `protobuf:"fixed64,5,opt,name=information_content,json=informationContent,proto3" json:"information_content,omitempty"`
+	// is_null reports whether the token lacks the attribute.
+	IsNull bool `protobuf:"varint,6,opt,name=is_null,json=isNull,proto3" json:"is_null,omitempty"`
+}
+
+func (x *AttributeExplanation) Reset() {
+	*x = AttributeExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeExplanation) ProtoMessage() {}
+
+func (x *AttributeExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeExplanation.ProtoReflect.Descriptor instead.
+func (*AttributeExplanation) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AttributeExplanation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeExplanation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AttributeExplanation) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *AttributeExplanation) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *AttributeExplanation) GetInformationContent() float64 {
+	if x != nil {
+		return x.InformationContent
+	}
+	return 0
+}
+
+func (x *AttributeExplanation) GetIsNull() bool {
+	if x != nil {
+		return x.IsNull
+	}
+	return false
+}
+
+type ExplainTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenIdentifier *TokenIdentifier `protobuf:"bytes,1,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	// attributes holds the contribution of every attribute, sorted by attribute name.
+	Attributes         []*AttributeExplanation `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	InformationContent float64                 `protobuf:"fixed64,3,opt,name=information_content,json=informationContent,proto3" json:"information_content,omitempty"`
+	EntropyNormalizer  float64                 `protobuf:"fixed64,4,opt,name=entropy_normalizer,json=entropyNormalizer,proto3" json:"entropy_normalizer,omitempty"`
+	Score              float64                 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ExplainTokenResponse) Reset() {
+	*x = ExplainTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openrarity_v1_openrarity_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainTokenResponse) ProtoMessage() {}
+
+func (x *ExplainTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openrarity_v1_openrarity_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainTokenResponse.ProtoReflect.Descriptor instead.
+func (*ExplainTokenResponse) Descriptor() ([]byte, []int) {
+	return file_openrarity_v1_openrarity_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExplainTokenResponse) GetTokenIdentifier() *TokenIdentifier {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *ExplainTokenResponse) GetAttributes() []*AttributeExplanation {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ExplainTokenResponse) GetInformationContent() float64 {
+	if x != nil {
+		return x.InformationContent
+	}
+	return 0
+}
+
+func (x *ExplainTokenResponse) GetEntropyNormalizer() float64 {
+	if x != nil {
+		return x.EntropyNormalizer
+	}
+	return 0
+}
+
+func (x *ExplainTokenResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_openrarity_v1_openrarity_proto protoreflect.FileDescriptor
+
+var file_openrarity_v1_openrarity_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x76, 0x31, 0x2f,
+	0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x22,
+	0x62, 0x0a, 0x1a, 0x45, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x20, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4d, 0x69, 0x6e,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5f, 0x0a, 0x17, 0x54, 0x65,
+	0x7a, 0x6f, 0x73, 0x46, 0x41, 0x32, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x22, 0x4f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x49, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x19, 0x4d, 0x6f, 0x76, 0x65,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x22, 0x62, 0x0a, 0x1a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x43, 0x57, 0x37, 0x32,
+	0x31, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0xeb, 0x04, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x65, 0x76,
+	0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x65,
+	0x76, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x61, 0x0a, 0x13, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4d, 0x69,
+	0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x11, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a,
+	0x09, 0x74, 0x65, 0x7a, 0x6f, 0x73, 0x5f, 0x66, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x7a, 0x6f, 0x73, 0x46, 0x41, 0x32, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x7a, 0x6f,
+	0x73, 0x46, 0x61, 0x32, 0x12, 0x66, 0x0a, 0x14, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x73,
+	0x5f, 0x69, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x49, 0x6e, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x73, 0x49, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0c,
+	0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b,
+	0x61, 0x70, 0x74, 0x6f, 0x73, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x73,
+	0x75, 0x69, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x69,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5f, 0x63, 0x77, 0x37, 0x32, 0x31, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x43, 0x57, 0x37, 0x32, 0x31, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x43, 0x77, 0x37, 0x32, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x22, 0x59, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x9a, 0x04, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x5f, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x62, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x75, 0x6d,
+	0x65, 0x72, 0x69, 0x63, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x1a, 0x43, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x61, 0x0a, 0x16, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x44, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb3, 0x01, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x6a, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0xd8,
+	0x01, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4d, 0x0a,
+	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x3f, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x6d, 0x0a, 0x16, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22,
+	0x31, 0x0a, 0x17, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x15, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x5b, 0x0a, 0x16, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x61, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xc5,
+	0x01, 0x0a, 0x1b, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0xcf,
+	0x01, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6e, 0x75,
+	0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4e, 0x75, 0x6c, 0x6c,
+	0x22, 0x9c, 0x02, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e,
+	0x74, 0x72, 0x6f, 0x70, 0x79, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x70, 0x79, 0x4e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2a,
+	0x79, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x4e,
+	0x4b, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x10, 0x02,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x4f, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x32, 0x8d, 0x03, 0x0a, 0x0d, 0x52,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x0f,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d,
+	0x0a, 0x0e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x14, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x72, 0x69, 0x74, 0x79, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x57, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x61, 0x73, 0x65, 0x2d, 0x4c, 0x61,
+	0x62, 0x73, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x72, 0x70,
+	0x63, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x76, 0x31, 0x3b, 0x6f,
+	0x70, 0x65, 0x6e, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_openrarity_v1_openrarity_proto_rawDescOnce sync.Once
+	file_openrarity_v1_openrarity_proto_rawDescData = file_openrarity_v1_openrarity_proto_rawDesc
+)
+
+func file_openrarity_v1_openrarity_proto_rawDescGZIP() []byte {
+	file_openrarity_v1_openrarity_proto_rawDescOnce.Do(func() {
+		file_openrarity_v1_openrarity_proto_rawDescData = protoimpl.X.CompressGZIP(file_openrarity_v1_openrarity_proto_rawDescData)
+	})
+	return file_openrarity_v1_openrarity_proto_rawDescData
+}
+
+var file_openrarity_v1_openrarity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_openrarity_v1_openrarity_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_openrarity_v1_openrarity_proto_goTypes = []interface{}{
+	(RankingMode)(0),                           // 0: openrarity.v1.RankingMode
+	(*EVMContractTokenIdentifier)(nil),         // 1: openrarity.v1.EVMContractTokenIdentifier
+	(*SolanaMintAddressTokenIdentifier)(nil),   // 2: openrarity.v1.SolanaMintAddressTokenIdentifier
+	(*TezosFA2TokenIdentifier)(nil),            // 3: openrarity.v1.TezosFA2TokenIdentifier
+	(*OrdinalsInscriptionTokenIdentifier)(nil), // 4: openrarity.v1.OrdinalsInscriptionTokenIdentifier
+	(*MoveObjectTokenIdentifier)(nil),          // 5: openrarity.v1.MoveObjectTokenIdentifier
+	(*CosmosCW721TokenIdentifier)(nil),         // 6: openrarity.v1.CosmosCW721TokenIdentifier
+	(*TokenIdentifier)(nil),                    // 7: openrarity.v1.TokenIdentifier
+	(*NumericValue)(nil),                       // 8: openrarity.v1.NumericValue
+	(*TokenMetadata)(nil),                      // 9: openrarity.v1.TokenMetadata
+	(*Token)(nil),                              // 10: openrarity.v1.Token
+	(*Collection)(nil),                         // 11: openrarity.v1.Collection
+	(*TokenRankingFeatures)(nil),               // 12: openrarity.v1.TokenRankingFeatures
+	(*TokenRarity)(nil),                        // 13: openrarity.v1.TokenRarity
+	(*ScoreCollectionRequest)(nil),             // 14: openrarity.v1.ScoreCollectionRequest
+	(*ScoreCollectionResponse)(nil),            // 15: openrarity.v1.ScoreCollectionResponse
+	(*RankCollectionRequest)(nil),              // 16: openrarity.v1.RankCollectionRequest
+	(*RankCollectionResponse)(nil),             // 17: openrarity.v1.RankCollectionResponse
+	(*RankCollectionStreamRequest)(nil),        // 18: openrarity.v1.RankCollectionStreamRequest
+	(*ExplainTokenRequest)(nil),                // 19: openrarity.v1.ExplainTokenRequest
+	(*AttributeExplanation)(nil),               // 20: openrarity.v1.AttributeExplanation
+	(*ExplainTokenResponse)(nil),               // 21: openrarity.v1.ExplainTokenResponse
+	nil,                                        // 22: openrarity.v1.TokenMetadata.StringAttributesEntry
+	nil,                                        // 23: openrarity.v1.TokenMetadata.NumericAttributesEntry
+	nil,                                        // 24: openrarity.v1.TokenMetadata.DateAttributesEntry
+	nil,                                        // 25: openrarity.v1.TokenRankingFeatures.FeaturesEntry
+}
+var file_openrarity_v1_openrarity_proto_depIdxs = []int32{
+	1,  // 0: openrarity.v1.TokenIdentifier.evm_contract:type_name -> openrarity.v1.EVMContractTokenIdentifier
+	2,  // 1: openrarity.v1.TokenIdentifier.solana_mint_address:type_name -> openrarity.v1.SolanaMintAddressTokenIdentifier
+	3,  // 2: openrarity.v1.TokenIdentifier.tezos_fa2:type_name -> openrarity.v1.TezosFA2TokenIdentifier
+	4,  // 3: openrarity.v1.TokenIdentifier.ordinals_inscription:type_name -> openrarity.v1.OrdinalsInscriptionTokenIdentifier
+	5,  // 4: openrarity.v1.TokenIdentifier.aptos_object:type_name -> openrarity.v1.MoveObjectTokenIdentifier
+	5,  // 5: openrarity.v1.TokenIdentifier.sui_object:type_name -> openrarity.v1.MoveObjectTokenIdentifier
+	6,  // 6: openrarity.v1.TokenIdentifier.cosmos_cw721:type_name -> openrarity.v1.CosmosCW721TokenIdentifier
+	22, // 7: openrarity.v1.TokenMetadata.string_attributes:type_name -> openrarity.v1.TokenMetadata.StringAttributesEntry
+	23, // 8: openrarity.v1.TokenMetadata.numeric_attributes:type_name -> openrarity.v1.TokenMetadata.NumericAttributesEntry
+	24, // 9: openrarity.v1.TokenMetadata.date_attributes:type_name -> openrarity.v1.TokenMetadata.DateAttributesEntry
+	7,  // 10: openrarity.v1.Token.token_identifier:type_name -> openrarity.v1.TokenIdentifier
+	9,  // 11: openrarity.v1.Token.metadata:type_name -> openrarity.v1.TokenMetadata
+	10, // 12: openrarity.v1.Collection.tokens:type_name -> openrarity.v1.Token
+	25, // 13: openrarity.v1.TokenRankingFeatures.features:type_name -> openrarity.v1.TokenRankingFeatures.FeaturesEntry
+	10, // 14: openrarity.v1.TokenRarity.token:type_name -> openrarity.v1.Token
+	12, // 15: openrarity.v1.TokenRarity.features:type_name -> openrarity.v1.TokenRankingFeatures
+	11, // 16: openrarity.v1.ScoreCollectionRequest.collection:type_name -> openrarity.v1.Collection
+	11, // 17: openrarity.v1.RankCollectionRequest.collection:type_name -> openrarity.v1.Collection
+	0,  // 18: openrarity.v1.RankCollectionRequest.mode:type_name -> openrarity.v1.RankingMode
+	13, // 19: openrarity.v1.RankCollectionResponse.token_rarities:type_name -> openrarity.v1.TokenRarity
+	0,  // 20: openrarity.v1.RankCollectionStreamRequest.mode:type_name -> openrarity.v1.RankingMode
+	10, // 21: openrarity.v1.RankCollectionStreamRequest.tokens:type_name -> openrarity.v1.Token
+	11, // 22: openrarity.v1.ExplainTokenRequest.collection:type_name -> openrarity.v1.Collection
+	7,  // 23: openrarity.v1.ExplainTokenRequest.token_identifier:type_name -> openrarity.v1.TokenIdentifier
+	7,  // 24: openrarity.v1.ExplainTokenResponse.token_identifier:type_name -> openrarity.v1.TokenIdentifier
+	20, // 25: openrarity.v1.ExplainTokenResponse.attributes:type_name -> openrarity.v1.AttributeExplanation
+	8,  // 26: openrarity.v1.TokenMetadata.NumericAttributesEntry.value:type_name -> openrarity.v1.NumericValue
+	14, // 27: openrarity.v1.RarityService.ScoreCollection:input_type -> openrarity.v1.ScoreCollectionRequest
+	16, // 28: openrarity.v1.RarityService.RankCollection:input_type -> openrarity.v1.RankCollectionRequest
+	18, // 29: openrarity.v1.RarityService.RankCollectionStream:input_type -> openrarity.v1.RankCollectionStreamRequest
+	19, // 30: openrarity.v1.RarityService.ExplainToken:input_type -> openrarity.v1.ExplainTokenRequest
+	15, // 31: openrarity.v1.RarityService.ScoreCollection:output_type -> openrarity.v1.ScoreCollectionResponse
+	17, // 32: openrarity.v1.RarityService.RankCollection:output_type -> openrarity.v1.RankCollectionResponse
+	13, // 33: openrarity.v1.RarityService.RankCollectionStream:output_type -> openrarity.v1.TokenRarity
+	21, // 34: openrarity.v1.RarityService.ExplainToken:output_type -> openrarity.v1.ExplainTokenResponse
+	31, // [31:35] is the sub-list for method output_type
+	27, // [27:31] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_openrarity_v1_openrarity_proto_init() }
+func file_openrarity_v1_openrarity_proto_init() {
+	if File_openrarity_v1_openrarity_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_openrarity_v1_openrarity_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVMContractTokenIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolanaMintAddressTokenIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TezosFA2TokenIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrdinalsInscriptionTokenIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveObjectTokenIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmosCW721TokenIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumericValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Token); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenRankingFeatures); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenRarity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankCollectionStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openrarity_v1_openrarity_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_openrarity_v1_openrarity_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*TokenIdentifier_EvmContract)(nil),
+		(*TokenIdentifier_SolanaMintAddress)(nil),
+		(*TokenIdentifier_TezosFa2)(nil),
+		(*TokenIdentifier_OrdinalsInscription)(nil),
+		(*TokenIdentifier_AptosObject)(nil),
+		(*TokenIdentifier_SuiObject)(nil),
+		(*TokenIdentifier_CosmosCw721)(nil),
+	}
+	file_openrarity_v1_openrarity_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*NumericValue_IntValue)(nil),
+		(*NumericValue_FloatValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_openrarity_v1_openrarity_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_openrarity_v1_openrarity_proto_goTypes,
+		DependencyIndexes: file_openrarity_v1_openrarity_proto_depIdxs,
+		EnumInfos:         file_openrarity_v1_openrarity_proto_enumTypes,
+		MessageInfos:      file_openrarity_v1_openrarity_proto_msgTypes,
+	}.Build()
+	File_openrarity_v1_openrarity_proto = out.File
+	file_openrarity_v1_openrarity_proto_rawDesc = nil
+	file_openrarity_v1_openrarity_proto_goTypes = nil
+	file_openrarity_v1_openrarity_proto_depIdxs = nil
+}
@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: openrarity/v1/openrarity.proto
+
+package openrarityv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RarityServiceClient is the client API for RarityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RarityServiceClient interface {
+	// ScoreCollection is used to score every token of a collection.
+	ScoreCollection(ctx context.Context, in *ScoreCollectionRequest, opts ...grpc.CallOption) (*ScoreCollectionResponse, error)
+	// RankCollection is used to score and rank every token of a collection.
+	RankCollection(ctx context.Context, in *RankCollectionRequest, opts ...grpc.CallOption) (*RankCollectionResponse, error)
+	// RankCollectionStream is the streaming variant of RankCollection for large collections.
+	// The tokens of the collection are sent in chunks, and the ranked tokens are streamed back,
+	// sorted by rank, once the client has closed its side of the stream.
+	RankCollectionStream(ctx context.Context, opts ...grpc.CallOption) (RarityService_RankCollectionStreamClient, error)
+	// ExplainToken is used to break down the score of a token into the contribution of each of
+	// its attributes.
+	ExplainToken(ctx context.Context, in *ExplainTokenRequest, opts ...grpc.CallOption) (*ExplainTokenResponse, error)
+}
+
+type rarityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRarityServiceClient(cc grpc.ClientConnInterface) RarityServiceClient {
+	return &rarityServiceClient{cc}
+}
+
+func (c *rarityServiceClient) ScoreCollection(ctx context.Context, in *ScoreCollectionRequest, opts ...grpc.CallOption) (*ScoreCollectionResponse, error) {
+	out := new(ScoreCollectionResponse)
+	err := c.cc.Invoke(ctx, "/openrarity.v1.RarityService/ScoreCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rarityServiceClient) RankCollection(ctx context.Context, in *RankCollectionRequest, opts ...grpc.CallOption) (*RankCollectionResponse, error) {
+	out := new(RankCollectionResponse)
+	err := c.cc.Invoke(ctx, "/openrarity.v1.RarityService/RankCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rarityServiceClient) RankCollectionStream(ctx context.Context, opts ...grpc.CallOption) (RarityService_RankCollectionStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RarityService_ServiceDesc.Streams[0], "/openrarity.v1.RarityService/RankCollectionStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rarityServiceRankCollectionStreamClient{stream}
+	return x, nil
+}
+
+type RarityService_RankCollectionStreamClient interface {
+	Send(*RankCollectionStreamRequest) error
+	Recv() (*TokenRarity, error)
+	grpc.ClientStream
+}
+
+type rarityServiceRankCollectionStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *rarityServiceRankCollectionStreamClient) Send(m *RankCollectionStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *rarityServiceRankCollectionStreamClient) Recv() (*TokenRarity, error) {
+	m := new(TokenRarity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rarityServiceClient) ExplainToken(ctx context.Context, in *ExplainTokenRequest, opts ...grpc.CallOption) (*ExplainTokenResponse, error) {
+	out := new(ExplainTokenResponse)
+	err := c.cc.Invoke(ctx, "/openrarity.v1.RarityService/ExplainToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RarityServiceServer is the server API for RarityService service.
+// All implementations must embed UnimplementedRarityServiceServer
+// for forward compatibility
+type RarityServiceServer interface {
+	// ScoreCollection is used to score every token of a collection.
+	ScoreCollection(context.Context, *ScoreCollectionRequest) (*ScoreCollectionResponse, error)
+	// RankCollection is used to score and rank every token of a collection.
+	RankCollection(context.Context, *RankCollectionRequest) (*RankCollectionResponse, error)
+	// RankCollectionStream is the streaming variant of RankCollection for large collections.
+	// The tokens of the collection are sent in chunks, and the ranked tokens are streamed back,
+	// sorted by rank, once the client has closed its side of the stream.
+	RankCollectionStream(RarityService_RankCollectionStreamServer) error
+	// ExplainToken is used to break down the score of a token into the contribution of each of
+	// its attributes.
+	ExplainToken(context.Context, *ExplainTokenRequest) (*ExplainTokenResponse, error)
+	mustEmbedUnimplementedRarityServiceServer()
+}
+
+// UnimplementedRarityServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRarityServiceServer struct {
+}
+
+func (UnimplementedRarityServiceServer) ScoreCollection(context.Context, *ScoreCollectionRequest) (*ScoreCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScoreCollection not implemented")
+}
+func (UnimplementedRarityServiceServer) RankCollection(context.Context, *RankCollectionRequest) (*RankCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RankCollection not implemented")
+}
+func (UnimplementedRarityServiceServer) RankCollectionStream(RarityService_RankCollectionStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RankCollectionStream not implemented")
+}
+func (UnimplementedRarityServiceServer) ExplainToken(context.Context, *ExplainTokenRequest) (*ExplainTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainToken not implemented")
+}
+func (UnimplementedRarityServiceServer) mustEmbedUnimplementedRarityServiceServer() {}
+
+// UnsafeRarityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RarityServiceServer will
+// result in compilation errors.
+type UnsafeRarityServiceServer interface {
+	mustEmbedUnimplementedRarityServiceServer()
+}
+
+func RegisterRarityServiceServer(s grpc.ServiceRegistrar, srv RarityServiceServer) {
+	s.RegisterService(&RarityService_ServiceDesc, srv)
+}
+
+func _RarityService_ScoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RarityServiceServer).ScoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openrarity.v1.RarityService/ScoreCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RarityServiceServer).ScoreCollection(ctx, req.(*ScoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RarityService_RankCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RarityServiceServer).RankCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openrarity.v1.RarityService/RankCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RarityServiceServer).RankCollection(ctx, req.(*RankCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RarityService_RankCollectionStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RarityServiceServer).RankCollectionStream(&rarityServiceRankCollectionStreamServer{stream})
+}
+
+type RarityService_RankCollectionStreamServer interface {
+	Send(*TokenRarity) error
+	Recv() (*RankCollectionStreamRequest, error)
+	grpc.ServerStream
+}
+
+type rarityServiceRankCollectionStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *rarityServiceRankCollectionStreamServer) Send(m *TokenRarity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rarityServiceRankCollectionStreamServer) Recv() (*RankCollectionStreamRequest, error) {
+	m := new(RankCollectionStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RarityService_ExplainToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RarityServiceServer).ExplainToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openrarity.v1.RarityService/ExplainToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RarityServiceServer).ExplainToken(ctx, req.(*ExplainTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RarityService_ServiceDesc is the grpc.ServiceDesc for RarityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RarityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openrarity.v1.RarityService",
+	HandlerType: (*RarityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScoreCollection",
+			Handler:    _RarityService_ScoreCollection_Handler,
+		},
+		{
+			MethodName: "RankCollection",
+			Handler:    _RarityService_RankCollection_Handler,
+		},
+		{
+			MethodName: "ExplainToken",
+			Handler:    _RarityService_ExplainToken_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RankCollectionStream",
+			Handler:       _RarityService_RankCollectionStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "openrarity/v1/openrarity.proto",
+}
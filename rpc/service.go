@@ -0,0 +1,239 @@
+package rpc
+
+//go:generate protoc -I ../proto --go_out=.. --go_opt=module=github.com/Base-Labs/openrarity --go-grpc_out=.. --go-grpc_opt=module=github.com/Base-Labs/openrarity openrarity/v1/openrarity.proto
+
+import (
+	"context"
+	"io"
+
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/rpc/openrarityv1"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultHandler is the score handler of requests without one
+const DefaultHandler = handlers.InformationContentHandlerName
+
+// ErrTokenNotFound is returned when the token to explain is not in the collection
+var ErrTokenNotFound = errors.New("token not found")
+
+// Service implements the RarityService of the openrarity.v1 protobuf package, scoring tokens
+// with the score handlers registered in scoring and ranking them with RarityRanker.
+type Service struct {
+	openrarityv1.UnimplementedRarityServiceServer
+	collectionOptions []models.CollectionOption
+	rankerOptions     []openrarity.RarityRankerOption
+}
+
+var _ openrarityv1.RarityServiceServer = &Service{}
+
+// ServiceOption is used to configure the optional behaviours of Service
+type ServiceOption func(c *Service)
+
+// WithCollectionOptions is used to configure the collections built from requests, such as
+// the binners of their numeric and date attributes.
+func WithCollectionOptions(opts ...models.CollectionOption) ServiceOption {
+	return func(c *Service) {
+		c.collectionOptions = append(c.collectionOptions, opts...)
+	}
+}
+
+// WithRankerOptions is used to configure the rarity ranker, the ranking mode is set by requests
+func WithRankerOptions(opts ...openrarity.RarityRankerOption) ServiceOption {
+	return func(c *Service) {
+		c.rankerOptions = append(c.rankerOptions, opts...)
+	}
+}
+
+// NewService is the constructor of Service
+func NewService(opts ...ServiceOption) *Service {
+	c := &Service{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Register is used to register the service to a gRPC server
+func (c *Service) Register(registrar grpc.ServiceRegistrar) {
+	openrarityv1.RegisterRarityServiceServer(registrar, c)
+}
+
+// ScoreCollection is used to score every token of a collection
+func (c *Service) ScoreCollection(
+	_ context.Context,
+	request *openrarityv1.ScoreCollectionRequest,
+) (*openrarityv1.ScoreCollectionResponse, error) {
+	collection, err := CollectionFromProto(request.GetCollection(), c.collectionOptions...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	scorer, err := newScorer(request.GetHandler())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	scores, err := scorer.ScoreCollection(collection)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &openrarityv1.ScoreCollectionResponse{Scores: scores}, nil
+}
+
+// RankCollection is used to score and rank every token of a collection
+func (c *Service) RankCollection(
+	_ context.Context,
+	request *openrarityv1.RankCollectionRequest,
+) (*openrarityv1.RankCollectionResponse, error) {
+	collection, err := CollectionFromProto(request.GetCollection(), c.collectionOptions...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tokenRarities, err := c.rankCollection(collection, request.GetHandler(), request.GetMode())
+	if err != nil {
+		return nil, err
+	}
+	return &openrarityv1.RankCollectionResponse{TokenRarities: tokenRarities}, nil
+}
+
+// RankCollectionStream is used to rank a collection received in chunks, the ranked tokens are
+// sent back once the client has sent every chunk.
+func (c *Service) RankCollectionStream(stream openrarityv1.RarityService_RankCollectionStreamServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	tokens := first.GetTokens()
+	supplies := first.GetSupplies()
+	for {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		tokens = append(tokens, request.GetTokens()...)
+		supplies = append(supplies, request.GetSupplies()...)
+	}
+	collection, err := newCollection(first.GetName(), tokens, supplies, c.collectionOptions)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	tokenRarities, err := c.rankCollection(collection, first.GetHandler(), first.GetMode())
+	if err != nil {
+		return err
+	}
+	for _, tokenRarity := range tokenRarities {
+		if err := stream.Send(tokenRarity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExplainToken is used to break down the score of a token of a collection
+func (c *Service) ExplainToken(
+	_ context.Context,
+	request *openrarityv1.ExplainTokenRequest,
+) (*openrarityv1.ExplainTokenResponse, error) {
+	collection, err := CollectionFromProto(request.GetCollection(), c.collectionOptions...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	identifier, err := TokenIdentifierFromProto(request.GetTokenIdentifier())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var token models.IToken
+	for _, t := range collection.Tokens() {
+		if t.TokenIdentifier().Equal(identifier) {
+			token = t
+			break
+		}
+	}
+	if token == nil {
+		return nil, statusError(errors.Wrapf(ErrTokenNotFound, "%s", identifier))
+	}
+	scorer, err := newScorer(request.GetHandler())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	explainer, ok := scorer.(scoring.ITokenExplainer)
+	if !ok {
+		return nil, statusError(scoring.ErrExplanationUnsupported)
+	}
+	explanation, err := explainer.ExplainToken(collection, token)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	response, err := TokenExplanationToProto(explanation)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return response, nil
+}
+
+func (c *Service) rankCollection(
+	collection models.ICollection,
+	handler string,
+	mode openrarityv1.RankingMode,
+) ([]*openrarityv1.TokenRarity, error) {
+	scorer, err := newScorer(handler)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	rankingMode, err := RankingModeFromProto(mode)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	opts := append([]openrarity.RarityRankerOption{}, c.rankerOptions...)
+	opts = append(opts, openrarity.WithRankingMode(rankingMode))
+	tokenRarities, err := openrarity.NewRarityRanker(opts...).RankCollection(collection, scorer)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	messages := make([]*openrarityv1.TokenRarity, 0, len(tokenRarities))
+	for _, tokenRarity := range tokenRarities {
+		message, err := TokenRarityToProto(tokenRarity)
+		if err != nil {
+			return nil, statusError(err)
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func newScorer(handler string) (scoring.IScorer, error) {
+	if handler == "" {
+		handler = DefaultHandler
+	}
+	return openrarity.NewScorerByName(handler)
+}
+
+// statusError is used to convert an error of the scorer or the ranker to a gRPC status error
+func statusError(err error) error {
+	var report *scoring.ValidationReport
+	switch {
+	case errors.As(err, &report):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrTokenNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, scoring.ErrExplanationUnsupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, scoring.ErrUnknownScoreHandler),
+		errors.Is(err, openrarity.ErrUnknownRankingMode),
+		errors.Is(err, models.ErrInvalidTokenIdentifier):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,139 @@
+package scoring_test
+
+import (
+	"time"
+
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// numericBinnerFunc is used to implement INumericBinner with a function
+type numericBinnerFunc func(values []float64) []string
+
+func (f numericBinnerFunc) Bin(values []float64) []string {
+	return f(values)
+}
+
+var _ = Describe("Attribute Binner", func() {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	It("should bucket values with equal width", func() {
+		labels := models.NewEqualWidthBinner(3).Bin(values)
+		Expect(labels[0]).To(Equal("[1, 4)"))
+		Expect(labels[3]).To(Equal("[4, 7)"))
+		Expect(labels[9]).To(Equal("[7, 10]"))
+	})
+
+	It("should bucket values by quantiles", func() {
+		labels := models.NewQuantileBinner(2).Bin(values)
+		Expect(labels[4]).To(Equal("[1, 6)"))
+		Expect(labels[5]).To(Equal("[6, 10]"))
+	})
+
+	It("should bucket values by explicit edges", func() {
+		labels := models.NewEdgesBinner(5, 2).Bin([]float64{0, 2, 5, 11})
+		Expect(labels).To(Equal([]string{"[-inf, 2)", "[2, 5)", "[5, +inf)", "[5, +inf)"}))
+	})
+
+	It("should treat values as categories", func() {
+		labels := models.NewCategoricalBinner().Bin([]float64{1, 2.5})
+		Expect(labels).To(Equal([]string{"1", "2.5"}))
+	})
+
+	It("should score collections with binned numeric attributes", func() {
+		traits := []map[string]interface{}{
+			{"hat": "cap", "level": 1},
+			{"hat": "cap", "level": 2},
+			{"hat": "cap", "level": 3},
+			{"hat": "cap", "level": 4},
+			{"hat": "cap", "level": 99},
+		}
+		collection, err := GenerateCollectionWithTokenTraits(
+			traits,
+			models.IdentifierTypeEVMContract,
+			models.WithNumericBinner(models.NewEdgesBinner(10)),
+		)
+		Expect(err).To(BeNil())
+		Expect(collection.HasNumericAttribute()).To(BeFalse())
+		Expect(collection.TotalAttributeValues("level")).To(Equal(2))
+		Expect(collection.Tokens()[0].TraitCount()).To(Equal(3))
+
+		scores, err := openrarity.NewOpenRarityScorer().ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		Expect(scores[4] > scores[0]).To(BeTrue())
+		Expect(scores[0]).To(Equal(scores[3]))
+	})
+
+	It("should bin attributes without changing the given tokens", func() {
+		token := must(models.NewERC721Token("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 1, map[string]interface{}{
+			"level":    3,
+			"birthday": time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+		}))
+		collection := must(models.NewCollection("drop", []models.IToken{token},
+			models.WithNumericBinner(models.NewCategoricalBinner()),
+			models.WithDateBinner(models.NewDateWindowBinner(models.DateWindowYear)),
+		))
+		Expect(token.HasAttribute("level")).To(BeFalse())
+		Expect(token.HasAttribute("birthday")).To(BeFalse())
+		binned := collection.Tokens()[0]
+		Expect(binned.TokenIdentifier()).To(Equal(token.TokenIdentifier()))
+		Expect(binned.Metadata().StringAttributes()["level"].Value()).To(Equal("3"))
+		Expect(binned.Metadata().StringAttributes()["birthday"].Value()).To(Equal("2021"))
+		Expect(collection.HasNumericAttribute()).To(BeFalse())
+	})
+
+	It("should reject binners which don't return one label per value", func() {
+		traits := []map[string]interface{}{
+			{"level": 1},
+			{"level": 2},
+		}
+		for _, binner := range []models.INumericBinner{
+			numericBinnerFunc(func(values []float64) []string { return []string{"low"} }),
+			numericBinnerFunc(func(values []float64) []string { return []string{"low", "high", "max"} }),
+			numericBinnerFunc(func(values []float64) []string { return nil }),
+		} {
+			_, err := GenerateCollectionWithTokenTraits(
+				traits,
+				models.IdentifierTypeEVMContract,
+				models.WithNumericBinner(binner),
+			)
+			Expect(err).To(MatchError(models.ErrBinnerLabelsMismatch))
+		}
+	})
+
+	It("should bucket dates by calendar windows", func() {
+		date := time.Date(2022, 3, 14, 15, 9, 26, 0, time.UTC).Unix()
+		Expect(models.NewDateWindowBinner(models.DateWindowDay).Bin([]int64{date})).To(Equal([]string{"2022-03-14"}))
+		Expect(models.NewDateWindowBinner(models.DateWindowMonth).Bin([]int64{date})).To(Equal([]string{"2022-03"}))
+		Expect(models.NewDateWindowBinner(models.DateWindowYear).Bin([]int64{date})).To(Equal([]string{"2022"}))
+		Expect(models.NewDateWindowBinnerInLocation(models.DateWindowDay, nil).Bin([]int64{date})).To(
+			Equal([]string{"2022-03-14"}))
+		Expect(models.NewDateBoundariesBinner(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)).Bin([]int64{date})).To(
+			Equal([]string{"[2022-01-01T00:00:00Z, +inf)"}))
+	})
+
+	It("should score collections with binned date attributes", func() {
+		traits := []map[string]interface{}{
+			{"hat": "cap", "birthday": time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)},
+			{"hat": "cap", "birthday": time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)},
+			{"hat": "cap", "birthday": time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+			{"hat": "cap", "birthday": time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{"hat": "cap"},
+		}
+		collection, err := GenerateCollectionWithTokenTraits(
+			traits,
+			models.IdentifierTypeEVMContract,
+			models.WithDateBinner(models.NewDateWindowBinner(models.DateWindowYear)),
+		)
+		Expect(err).To(BeNil())
+		Expect(collection.HasNumericAttribute()).To(BeFalse())
+		Expect(collection.ExtractNullAttributes()["birthday"].TotalTokens).To(Equal(1))
+
+		scores, err := openrarity.NewOpenRarityScorer().ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		Expect(scores[3] > scores[0]).To(BeTrue())
+		Expect(scores[4] > scores[0]).To(BeTrue())
+	})
+})
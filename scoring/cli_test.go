@@ -0,0 +1,217 @@
+package scoring_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Base-Labs/openrarity/cli"
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CLI", func() {
+	csvDocument := "token_id,background,hat\n" +
+		"1,red,cap\n" +
+		"2,red,\n" +
+		"3,blue,crown\n" +
+		"4,red,cap\n"
+	jsonlDocument := `{"token_id": 1, "background": "red", "hat": "cap"}` + "\n" +
+		`{"token_id": 2, "background": "red"}` + "\n" +
+		`{"token_id": 3, "background": "blue", "hat": "crown"}` + "\n" +
+		`{"token_id": 4, "background": "red", "hat": "cap"}` + "\n"
+
+	var dir string
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	writeInput := func(name, document string) string {
+		input := filepath.Join(dir, name)
+		Expect(os.WriteFile(input, []byte(document), 0o644)).To(BeNil())
+		return input
+	}
+	run := func(args ...string) (int, string, string) {
+		var stdout, stderr bytes.Buffer
+		code := cli.Run(args, &stdout, &stderr)
+		return code, stdout.String(), stderr.String()
+	}
+
+	It("should exit with 2 when misused", func() {
+		input := writeInput("drop.csv", csvDocument)
+		code, _, stderr := run()
+		Expect(code).To(Equal(2))
+		Expect(stderr).To(ContainSubstring("Usage"))
+
+		code, _, _ = run("help")
+		Expect(code).To(Equal(0))
+
+		code, _, stderr = run("unknown", input)
+		Expect(code).To(Equal(2))
+		Expect(stderr).To(ContainSubstring("unknown"))
+
+		code, _, _ = run("rank", "-unknown", input)
+		Expect(code).To(Equal(2))
+
+		code, _, stderr = run("rank", input, input)
+		Expect(code).To(Equal(2))
+		Expect(stderr).To(ContainSubstring("expected one input, got 2"))
+
+		code, _, _ = run("stats", "-h")
+		Expect(code).To(Equal(0))
+	})
+
+	It("should exit with 1 when the command fails", func() {
+		input := writeInput("drop.csv", csvDocument)
+		code, _, stderr := run("rank", filepath.Join(dir, "missing.csv"))
+		Expect(code).To(Equal(1))
+		Expect(stderr).To(HavePrefix("openrarity rank: "))
+
+		code, _, stderr = run("rank", "-format", "xml", input)
+		Expect(code).To(Equal(1))
+		Expect(stderr).To(ContainSubstring(`unknown input format "xml"`))
+
+		code, _, stderr = run("rank", "-contract", testContractAddress, "-output-format", "xml", input)
+		Expect(code).To(Equal(1))
+		Expect(stderr).To(ContainSubstring("xml"))
+
+		code, _, stderr = run("explain", "-contract", testContractAddress, input)
+		Expect(code).To(Equal(1))
+		Expect(stderr).To(ContainSubstring("-token"))
+
+		code, _, _ = run("explain", "-contract", testContractAddress, "-token", "9", input)
+		Expect(code).To(Equal(1))
+
+		ambiguousInput := writeInput("drop.jsonl",
+			`{"token_identifier": "evm_contract:`+testContractAddress+`:1", "hat": "cap"}`+"\n"+
+				`{"token_identifier": "evm_contract:0x1000000000000000000000000000000000000001:1", "hat": "crown"}`+"\n",
+		)
+		code, _, stderr = run("explain", "-token", "1", ambiguousInput)
+		Expect(code).To(Equal(1))
+		Expect(stderr).To(ContainSubstring("ambiguous"))
+		code, _, _ = run("explain", "-token", testContractAddress+":1", ambiguousInput)
+		Expect(code).To(Equal(0))
+	})
+
+	It("should rank collections to the standard output as JSON", func() {
+		input := writeInput("drop.csv", csvDocument)
+		code, stdout, _ := run("rank", "-contract", testContractAddress, "-mode", "dense_rank", input)
+		Expect(code).To(Equal(0))
+		var records []*oio.RankedToken
+		Expect(json.Unmarshal([]byte(stdout), &records)).To(BeNil())
+		Expect(ranksOfRecords(records)).To(Equal([]int{1, 2, 3, 3}))
+		Expect(string(records[0].TokenID)).To(Equal("3"))
+	})
+
+	It("should infer the input format from the extension of the input", func() {
+		csvInput := writeInput("drop.csv", csvDocument)
+		jsonlInput := writeInput("drop.jsonl", jsonlDocument)
+		_, csvRanks, _ := run("rank", "-contract", testContractAddress, csvInput)
+		code, jsonlRanks, _ := run("rank", "-contract", testContractAddress, jsonlInput)
+		Expect(code).To(Equal(0))
+		Expect(jsonlRanks).To(Equal(csvRanks))
+
+		// the CSV document can't be read as JSON Lines
+		code, _, _ = run("rank", "-contract", testContractAddress, "-format", "jsonl", csvInput)
+		Expect(code).To(Equal(1))
+		renamed := writeInput("drop.txt", jsonlDocument)
+		code, stdout, _ := run("rank", "-contract", testContractAddress, "-format", "jsonl", renamed)
+		Expect(code).To(Equal(0))
+		Expect(stdout).To(Equal(csvRanks))
+	})
+
+	It("should infer the output format from the extension of the output file", func() {
+		input := writeInput("drop.csv", csvDocument)
+		csvOutput := filepath.Join(dir, "ranks.csv")
+		code, stdout, _ := run("rank", "-contract", testContractAddress, "-features", "-o", csvOutput, input)
+		Expect(code).To(Equal(0))
+		Expect(stdout).To(BeEmpty())
+		lines := strings.Split(strings.TrimSpace(string(must(os.ReadFile(csvOutput)))), "\n")
+		Expect(len(lines)).To(Equal(5))
+		Expect(lines[0]).To(HavePrefix("token_id,rank,score,unique_attribute_count,token_identifier,token_standard,"))
+
+		jsonlOutput := filepath.Join(dir, "ranks.jsonl")
+		code, _, _ = run("rank", "-contract", testContractAddress, "-o", jsonlOutput, input)
+		Expect(code).To(Equal(0))
+		lines = strings.Split(strings.TrimSpace(string(must(os.ReadFile(jsonlOutput)))), "\n")
+		Expect(len(lines)).To(Equal(4))
+		var record oio.RankedToken
+		Expect(json.Unmarshal([]byte(lines[0]), &record)).To(BeNil())
+		Expect(record.Rank).To(Equal(1))
+
+		code, _, _ = run("rank", "-contract", testContractAddress, "-o", csvOutput, "-output-format", "json", input)
+		Expect(code).To(Equal(0))
+		var records []*oio.RankedToken
+		Expect(json.Unmarshal(must(os.ReadFile(csvOutput)), &records)).To(BeNil())
+		Expect(len(records)).To(Equal(4))
+	})
+
+	It("should explain the score of a token", func() {
+		input := writeInput("drop.csv", csvDocument)
+		code, stdout, _ := run("explain", "-contract", testContractAddress, "-token", "3", input)
+		Expect(code).To(Equal(0))
+		Expect(stdout).To(ContainSubstring("token:               evm_contract:" + testContractAddress + ":3"))
+		Expect(stdout).To(ContainSubstring("TRAIT"))
+		Expect(stdout).To(ContainSubstring("crown"))
+
+		code, stdout, _ = run("explain", "-contract", testContractAddress, "-token", "2", "-json", input)
+		Expect(code).To(Equal(0))
+		var explanation struct {
+			TokenIdentifier string  `json:"token_identifier"`
+			Score           float64 `json:"score"`
+			Attributes      []struct {
+				Name   string `json:"name"`
+				IsNull bool   `json:"is_null"`
+			} `json:"attributes"`
+		}
+		Expect(json.Unmarshal([]byte(stdout), &explanation)).To(BeNil())
+		Expect(explanation.TokenIdentifier).To(Equal("evm_contract:" + testContractAddress + ":2"))
+		Expect(explanation.Score).To(BeNumerically(">", 0))
+		Expect(explanation.Attributes).To(ContainElement(HaveField("IsNull", true)))
+	})
+
+	It("should validate collections", func() {
+		input := writeInput("drop.csv", csvDocument)
+		code, stdout, _ := run("validate", "-contract", testContractAddress, input)
+		Expect(code).To(Equal(0))
+		Expect(stdout).To(Equal("ok: 4 tokens can be scored\n"))
+
+		code, stdout, _ = run("validate", "-contract", testContractAddress, "-min-size", "5", "-strict", input)
+		Expect(code).To(Equal(1))
+		Expect(stdout).To(HavePrefix(scoring.RuleMinCollectionSize + ": "))
+	})
+
+	It("should write the statistics of collections", func() {
+		input := writeInput("drop.csv", csvDocument)
+		code, stdout, _ := run("stats", "-contract", testContractAddress, "-json", input)
+		Expect(code).To(Equal(0))
+		var stats struct {
+			Name   string `json:"name"`
+			Tokens int    `json:"tokens"`
+			Traits []struct {
+				Name   string `json:"name"`
+				Values []struct {
+					Value  string `json:"value"`
+					Tokens int    `json:"tokens"`
+				} `json:"values"`
+			} `json:"traits"`
+		}
+		Expect(json.Unmarshal([]byte(stdout), &stats)).To(BeNil())
+		Expect(stats.Name).To(Equal("drop"))
+		Expect(stats.Tokens).To(Equal(4))
+		names := make([]string, 0, len(stats.Traits))
+		for _, trait := range stats.Traits {
+			names = append(names, trait.Name)
+		}
+		Expect(names).To(ConsistOf("background", "hat", models.TraitCountAttributeName))
+
+		code, stdout, _ = run("stats", "-contract", testContractAddress, "-name", "other", input)
+		Expect(code).To(Equal(0))
+		Expect(stdout).To(ContainSubstring("other"))
+	})
+})
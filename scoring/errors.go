@@ -0,0 +1,20 @@
+package scoring
+
+import (
+	"github.com/pkg/errors"
+)
+
+// defines a set of errors returned by the scorer
+var (
+	// ErrUnsupportedStandard is returned when a collection holds tokens of unsupported standards
+	ErrUnsupportedStandard = errors.New("OpenRarity currently only supports non-fungible token standards, " +
+		"and ERC1155 tokens of collections with known supplies")
+	// ErrNumericTraitsUnsupported is returned when a collection holds numeric or date traits
+	// which have not been bucketed into string attributes
+	ErrNumericTraitsUnsupported = errors.New("OpenRarity currently does not support collections with " +
+		"numeric or date traits")
+	// ErrExplanationUnsupported is returned when the score handler is unable to explain scores
+	ErrExplanationUnsupported = errors.New("score handler doesn't support explaining token scores")
+	// ErrUnknownScoreHandler is returned when no score handler is registered under a name
+	ErrUnknownScoreHandler = errors.New("unknown score handler")
+)
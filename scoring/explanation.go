@@ -0,0 +1,61 @@
+package scoring
+
+import (
+	"encoding/json"
+
+	"github.com/Base-Labs/openrarity/models"
+)
+
+// ITokenExplainer is implemented by the score handlers which are able to explain
+// how the score of a token is computed.
+type ITokenExplainer interface {
+	// ExplainToken is used to break down the score of a token into the contribution
+	// of each of its attributes.
+	ExplainToken(collection models.ICollection, token models.IToken) (*TokenExplanation, error)
+}
+
+// TokenExplanation describes how the score of a token is computed
+type TokenExplanation struct {
+	// Token is the explained token
+	Token models.IToken `json:"-"`
+	// Attributes holds the contribution of every attribute, sorted by attribute name
+	Attributes []*AttributeExplanation `json:"attributes"`
+	// InformationContent is the sum of the information content of all attributes, in bits
+	InformationContent float64 `json:"information_content"`
+	// EntropyNormalizer is the collection entropy the information content is divided by
+	EntropyNormalizer float64 `json:"entropy_normalizer"`
+	// Score is the rarity score of the token
+	Score float64 `json:"score"`
+}
+
+// MarshalJSON is used to encode the explanation, with the canonical form of the identifier
+// of the explained token, see models.ITokenIdentifier.
+func (e *TokenExplanation) MarshalJSON() ([]byte, error) {
+	type tokenExplanation TokenExplanation
+	v := struct {
+		TokenIdentifier string `json:"token_identifier,omitempty"`
+		*tokenExplanation
+	}{
+		tokenExplanation: (*tokenExplanation)(e),
+	}
+	if e.Token != nil {
+		v.TokenIdentifier = e.Token.TokenIdentifier().String()
+	}
+	return json.Marshal(v)
+}
+
+// AttributeExplanation describes the contribution of an attribute to the score of a token
+type AttributeExplanation struct {
+	// Name is the name of the attribute
+	Name models.AttributeName `json:"name"`
+	// Value is the value of the attribute
+	Value models.StringAttributeValue `json:"value"`
+	// TotalTokens is the number of tokens in the collection with the same value
+	TotalTokens int `json:"total_tokens"`
+	// Probability is the probability of the value in the collection
+	Probability float64 `json:"probability"`
+	// InformationContent is the information content of the value, in bits
+	InformationContent float64 `json:"information_content"`
+	// IsNull reports whether the token lacks the attribute, and the value is synthesized as Null
+	IsNull bool `json:"is_null"`
+}
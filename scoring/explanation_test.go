@@ -0,0 +1,52 @@
+package scoring_test
+
+import (
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token Explanation", func() {
+	It("should explain the information content of every attribute", func() {
+		collection, err := GenerateCollectionWithTokenTraits(
+			[]map[string]interface{}{
+				{"bottom": "spec", "hat": "spec", "special": "true"},
+				{"bottom": "1", "hat": "1", "special": "true"},
+				{"bottom": "1", "hat": "1"},
+				{"bottom": "2", "hat": "2"},
+			},
+			models.IdentifierTypeEVMContract,
+		)
+		Expect(err).To(BeNil())
+
+		scorer := openrarity.NewOpenRarityScorer()
+		scores, err := scorer.ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		explainer, ok := scorer.(scoring.ITokenExplainer)
+		Expect(ok).To(BeTrue())
+
+		for idx, token := range collection.Tokens() {
+			explanation, err := explainer.ExplainToken(collection, token)
+			Expect(err).To(BeNil())
+			Expect(explanation.Score).To(Equal(scores[idx]))
+			Expect(explanation.InformationContent / explanation.EntropyNormalizer).To(Equal(scores[idx]))
+
+			var names []string
+			for _, attr := range explanation.Attributes {
+				names = append(names, attr.Name)
+			}
+			Expect(names).To(Equal([]string{"bottom", "hat", models.TraitCountAttributeName, "special"}))
+		}
+
+		explanation, err := explainer.ExplainToken(collection, collection.Tokens()[2])
+		Expect(err).To(BeNil())
+		special := explanation.Attributes[3]
+		Expect(special.IsNull).To(BeTrue())
+		Expect(special.Value).To(Equal("null"))
+		Expect(special.Probability).To(Equal(0.5))
+		Expect(special.InformationContent).To(Equal(1.0))
+		Expect(explanation.Attributes[0].IsNull).To(BeFalse())
+	})
+})
@@ -0,0 +1,72 @@
+package scoring_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+
+	"github.com/Base-Labs/openrarity"
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/Base-Labs/openrarity/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Export", func() {
+	tokens := make([]models.IToken, 0, 3)
+	for idx, hat := range []string{"cap", "cap", "beanie"} {
+		tokens = append(tokens, CreateEVMToken(
+			idx,
+			"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			models.TokenStandardERC721,
+			must(models.NewTokenMetadataFromAttributes(map[string]interface{}{"hat": hat})),
+		))
+	}
+	collection := must(models.NewCollection("test", tokens))
+	tokenRarities := must(openrarity.NewRarityRanker().RankCollection(collection, openrarity.NewOpenRarityScorer()))
+
+	It("should write ranked tokens as JSON", func() {
+		var buf bytes.Buffer
+		Expect(oio.WriteJSON(&buf, tokenRarities)).To(Succeed())
+		var records []map[string]interface{}
+		Expect(json.Unmarshal(buf.Bytes(), &records)).To(Succeed())
+		Expect(len(records)).To(Equal(3))
+		Expect(records[0]["token_id"]).To(Equal(float64(2)))
+		Expect(records[0]["rank"]).To(Equal(float64(1)))
+		Expect(records[0]["score"]).To(BeNumerically(">", records[1]["score"]))
+		Expect(records[0]["unique_attribute_count"]).To(Equal(float64(1)))
+		Expect(records[0]["token_standard"]).To(Equal("erc721"))
+		Expect(records[0]["token_identifier"]).To(HaveKeyWithValue("identifier_type", "evm_contract"))
+		Expect(records[0]).NotTo(HaveKey("trait_probabilities"))
+	})
+
+	It("should write ranked tokens as JSON Lines", func() {
+		var buf bytes.Buffer
+		Expect(oio.WriteJSONL(&buf, tokenRarities, oio.WithFeatures(), oio.WithTraitProbabilities(collection))).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		Expect(len(lines)).To(Equal(3))
+		var record oio.RankedToken
+		Expect(json.Unmarshal([]byte(lines[0]), &record)).To(Succeed())
+		Expect(record.Rank).To(Equal(1))
+		Expect(record.TokenIdentifier.Equal(tokenRarities[0].Token().TokenIdentifier())).To(BeTrue())
+		Expect(record.Features).To(HaveKeyWithValue(models.UniqueAttributeCountFeatureName, float64(1)))
+		Expect(record.TraitProbabilities["hat"]).To(Equal(oio.TraitProbability{Value: "beanie", Probability: 1.0 / 3}))
+	})
+
+	It("should write ranked tokens as CSV", func() {
+		var buf bytes.Buffer
+		Expect(oio.WriteCSV(&buf, tokenRarities, oio.WithTraitProbabilities(collection))).To(Succeed())
+		rows, err := csv.NewReader(&buf).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(len(rows)).To(Equal(4))
+		Expect(rows[0]).To(Equal([]string{
+			"token_id", "rank", "score", "unique_attribute_count", "token_identifier", "token_standard",
+			"value:hat", "probability:hat", "value:meta_trait:trait_count", "probability:meta_trait:trait_count",
+		}))
+		Expect(rows[1][:2]).To(Equal([]string{"2", "1"}))
+		Expect(rows[1][4]).To(Equal(tokenRarities[0].Token().TokenIdentifier().String()))
+		Expect(rows[1][6:8]).To(Equal([]string{"beanie", "0.3333333333333333"}))
+		Expect(rows[2][6:8]).To(Equal([]string{"cap", "0.6666666666666666"}))
+	})
+})
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// ArithmeticMeanScoringHandler implements the scoring.IScoreHandler.
+// The score of a token is the weighted arithmetic mean of the inverse probabilities
+// of its attributes, the lack of an attribute being considered as a null-Value
+// attribute.
+type ArithmeticMeanScoringHandler struct{}
+
+var _ scoring.IScoreHandler = &ArithmeticMeanScoringHandler{}
+
+// NewArithmeticMeanScoringHandler is the constructor of ArithmeticMeanScoringHandler
+func NewArithmeticMeanScoringHandler() *ArithmeticMeanScoringHandler {
+	return &ArithmeticMeanScoringHandler{}
+}
+
+// ScoreToken is used to score an individual token based on the traits' distribution across
+// the whole collection.
+func (c *ArithmeticMeanScoringHandler) ScoreToken(collection models.ICollection, token models.IToken) (float64, error) {
+	return scoreTokensByAttributes(collection, []models.IToken{token}, false, arithmeticMean)[0], nil
+}
+
+// ScoreTokens should be used if you only want to score a batch of tokens that belong to collection.
+// This will typically be more efficient than calling score_token for each
+// token in `tokens`.
+func (c *ArithmeticMeanScoringHandler) ScoreTokens(collection models.ICollection, tokens []models.IToken) ([]float64, error) {
+	return scoreTokensByAttributes(collection, tokens, false, arithmeticMean), nil
+}
+
+func arithmeticMean(scores []float64, weights []float64) float64 {
+	totalWeight := sumFloat64(weights)
+	if totalWeight == 0 {
+		return 0
+	}
+	var result float64
+	for idx, score := range scores {
+		result += weights[idx] * score
+	}
+	return result / totalWeight
+}
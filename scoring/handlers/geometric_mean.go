@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"math"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// GeometricMeanScoringHandler implements the scoring.IScoreHandler.
+// The score of a token is the weighted geometric mean of the inverse probabilities
+// of its attributes, the lack of an attribute being considered as a null-Value
+// attribute.
+type GeometricMeanScoringHandler struct{}
+
+var _ scoring.IScoreHandler = &GeometricMeanScoringHandler{}
+
+// NewGeometricMeanScoringHandler is the constructor of GeometricMeanScoringHandler
+func NewGeometricMeanScoringHandler() *GeometricMeanScoringHandler {
+	return &GeometricMeanScoringHandler{}
+}
+
+// ScoreToken is used to score an individual token based on the traits' distribution across
+// the whole collection.
+func (c *GeometricMeanScoringHandler) ScoreToken(collection models.ICollection, token models.IToken) (float64, error) {
+	return scoreTokensByAttributes(collection, []models.IToken{token}, false, geometricMean)[0], nil
+}
+
+// ScoreTokens should be used if you only want to score a batch of tokens that belong to collection.
+// This will typically be more efficient than calling score_token for each
+// token in `tokens`.
+func (c *GeometricMeanScoringHandler) ScoreTokens(collection models.ICollection, tokens []models.IToken) ([]float64, error) {
+	return scoreTokensByAttributes(collection, tokens, false, geometricMean), nil
+}
+
+func geometricMean(scores []float64, weights []float64) float64 {
+	totalWeight := sumFloat64(weights)
+	if totalWeight == 0 {
+		return 0
+	}
+	var result float64
+	for idx, score := range scores {
+		result += weights[idx] * math.Log(score)
+	}
+	return math.Exp(result / totalWeight)
+}
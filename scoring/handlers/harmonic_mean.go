@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// HarmonicMeanScoringHandler implements the scoring.IScoreHandler.
+// The score of a token is the weighted harmonic mean of the inverse probabilities
+// of its attributes, the lack of an attribute being considered as a null-Value
+// attribute.
+type HarmonicMeanScoringHandler struct{}
+
+var _ scoring.IScoreHandler = &HarmonicMeanScoringHandler{}
+
+// NewHarmonicMeanScoringHandler is the constructor of HarmonicMeanScoringHandler
+func NewHarmonicMeanScoringHandler() *HarmonicMeanScoringHandler {
+	return &HarmonicMeanScoringHandler{}
+}
+
+// ScoreToken is used to score an individual token based on the traits' distribution across
+// the whole collection.
+func (c *HarmonicMeanScoringHandler) ScoreToken(collection models.ICollection, token models.IToken) (float64, error) {
+	return scoreTokensByAttributes(collection, []models.IToken{token}, false, harmonicMean)[0], nil
+}
+
+// ScoreTokens should be used if you only want to score a batch of tokens that belong to collection.
+// This will typically be more efficient than calling score_token for each
+// token in `tokens`.
+func (c *HarmonicMeanScoringHandler) ScoreTokens(collection models.ICollection, tokens []models.IToken) ([]float64, error) {
+	return scoreTokensByAttributes(collection, tokens, false, harmonicMean), nil
+}
+
+func harmonicMean(scores []float64, weights []float64) float64 {
+	var result float64
+	for idx, score := range scores {
+		result += weights[idx] / score
+	}
+	if result == 0 {
+		return 0
+	}
+	return sumFloat64(weights) / result
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"math"
+	"sort"
 
 	"github.com/Base-Labs/openrarity/models"
 	"github.com/Base-Labs/openrarity/scoring"
@@ -37,6 +38,7 @@ import (
 type InformationContentScoringHandler struct{}
 
 var _ scoring.IScoreHandler = &InformationContentScoringHandler{}
+var _ scoring.ITokenExplainer = &InformationContentScoringHandler{}
 
 // NewInformationContentScoringHandler is the constructor of InformationContentScoringHandler
 func NewInformationContentScoringHandler() *InformationContentScoringHandler {
@@ -46,6 +48,8 @@ func NewInformationContentScoringHandler() *InformationContentScoringHandler {
 // GetCollectionEntropy is used to Calculate the entropy of the collection,
 // defined to be the sum of the probability of every possible attribute name/value
 // pair that occurs in the collection times that square root of such probability.
+// Probabilities are summed in the order of attribute names and values, so that the
+// entropy is reproducible bit for bit.
 func (c *InformationContentScoringHandler) GetCollectionEntropy(
 	collection models.ICollection,
 	attributes map[models.AttributeName][]*models.CollectionAttribute,
@@ -57,8 +61,15 @@ func (c *InformationContentScoringHandler) GetCollectionEntropy(
 	if nullAttributes == nil {
 		nullAttributes = collection.ExtractNullAttributes()
 	}
+	attrNames := scoring.GetMapKeys(attributes)
+	sort.Strings(attrNames)
 	collectionProbabilities := make([]float64, 0, len(attributes))
-	for attrName, attrValues := range attributes {
+	for _, attrName := range attrNames {
+		attrValues := make([]*models.CollectionAttribute, 0, len(attributes[attrName])+1)
+		attrValues = append(attrValues, attributes[attrName]...)
+		sort.Slice(attrValues, func(i, j int) bool {
+			return attrValues[i].Attribute.Value() < attrValues[j].Attribute.Value()
+		})
 		if nullAttr := nullAttributes[attrName]; nullAttr != nil {
 			attrValues = append(attrValues, nullAttr)
 		}
@@ -119,6 +130,46 @@ func (c *InformationContentScoringHandler) ScoreToken(
 	), nil
 }
 
+// ExplainToken is used to break down the score of a token into the information content
+// of each of its attributes and the collection entropy normalization factor.
+func (c *InformationContentScoringHandler) ExplainToken(
+	collection models.ICollection,
+	token models.IToken,
+) (*scoring.TokenExplanation, error) {
+	collectionNullAttributes := collection.ExtractNullAttributes()
+	collectionEntropy := c.GetCollectionEntropy(
+		collection,
+		collection.ExtractCollectionAttributes(),
+		collectionNullAttributes,
+	)
+	if collectionEntropy == 0 {
+		collectionEntropy = 1
+	}
+	totalSupply := collection.TokenTotalSupply()
+	attributes := scoring.GetTokenCollectionAttributes(collection, token, collectionNullAttributes)
+	explanation := &scoring.TokenExplanation{
+		Token:             token,
+		Attributes:        make([]*scoring.AttributeExplanation, 0, len(attributes)),
+		EntropyNormalizer: collectionEntropy,
+	}
+	for _, attr := range attributes {
+		// same expression as getICScore, so that the explanation adds up to the score
+		score := float64(totalSupply) / float64(attr.TotalTokens)
+		informationContent := -1 * math.Log2(1/score)
+		explanation.Attributes = append(explanation.Attributes, &scoring.AttributeExplanation{
+			Name:               attr.Attribute.Name(),
+			Value:              attr.Attribute.Value(),
+			TotalTokens:        attr.TotalTokens,
+			Probability:        float64(attr.TotalTokens) / float64(totalSupply),
+			InformationContent: informationContent,
+			IsNull:             !token.HasAttribute(attr.Attribute.Name()),
+		})
+		explanation.InformationContent += informationContent
+	}
+	explanation.Score = explanation.InformationContent / collectionEntropy
+	return explanation, nil
+}
+
 // scoreToken is used to calculate the score of the token using information
 // entropy with a collection entropy normalization factor.
 func (c *InformationContentScoringHandler) scoreToken(
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// defines the names the built-in score handlers are registered under
+const (
+	InformationContentHandlerName = "information_content"
+	ArithmeticMeanHandlerName     = "arithmetic_mean"
+	GeometricMeanHandlerName      = "geometric_mean"
+	HarmonicMeanHandlerName       = "harmonic_mean"
+	SumHandlerName                = "sum"
+	TraitNormalizedHandlerName    = "trait_normalized"
+)
+
+func init() {
+	scoring.RegisterScoreHandler(InformationContentHandlerName, func() scoring.IScoreHandler {
+		return NewInformationContentScoringHandler()
+	})
+	scoring.RegisterScoreHandler(ArithmeticMeanHandlerName, func() scoring.IScoreHandler {
+		return NewArithmeticMeanScoringHandler()
+	})
+	scoring.RegisterScoreHandler(GeometricMeanHandlerName, func() scoring.IScoreHandler {
+		return NewGeometricMeanScoringHandler()
+	})
+	scoring.RegisterScoreHandler(HarmonicMeanHandlerName, func() scoring.IScoreHandler {
+		return NewHarmonicMeanScoringHandler()
+	})
+	scoring.RegisterScoreHandler(SumHandlerName, func() scoring.IScoreHandler {
+		return NewSumScoringHandler()
+	})
+	scoring.RegisterScoreHandler(TraitNormalizedHandlerName, func() scoring.IScoreHandler {
+		return NewTraitNormalizedScoringHandler()
+	})
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// SumScoringHandler implements the scoring.IScoreHandler.
+// The score of a token is the sum of the inverse probabilities of its attributes,
+// the lack of an attribute being considered as a null-Value attribute. This is the
+// score popularized by rarity.tools.
+type SumScoringHandler struct{}
+
+var _ scoring.IScoreHandler = &SumScoringHandler{}
+
+// NewSumScoringHandler is the constructor of SumScoringHandler
+func NewSumScoringHandler() *SumScoringHandler {
+	return &SumScoringHandler{}
+}
+
+// ScoreToken is used to score an individual token based on the traits' distribution across
+// the whole collection.
+func (c *SumScoringHandler) ScoreToken(collection models.ICollection, token models.IToken) (float64, error) {
+	return scoreTokensByAttributes(collection, []models.IToken{token}, false, sumScores)[0], nil
+}
+
+// ScoreTokens should be used if you only want to score a batch of tokens that belong to collection.
+// This will typically be more efficient than calling score_token for each
+// token in `tokens`.
+func (c *SumScoringHandler) ScoreTokens(collection models.ICollection, tokens []models.IToken) ([]float64, error) {
+	return scoreTokensByAttributes(collection, tokens, false, sumScores), nil
+}
+
+func sumScores(scores []float64, _ []float64) float64 {
+	return sumFloat64(scores)
+}
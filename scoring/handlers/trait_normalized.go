@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// TraitNormalizedScoringHandler implements the scoring.IScoreHandler.
+// The score of a token is the sum of the inverse probabilities of its attributes,
+// each weighted by one over the number of values of the attribute in the collection.
+// Without the normalization, the traits with many possible values dominate the score
+// because every one of their values is rare.
+type TraitNormalizedScoringHandler struct{}
+
+var _ scoring.IScoreHandler = &TraitNormalizedScoringHandler{}
+
+// NewTraitNormalizedScoringHandler is the constructor of TraitNormalizedScoringHandler
+func NewTraitNormalizedScoringHandler() *TraitNormalizedScoringHandler {
+	return &TraitNormalizedScoringHandler{}
+}
+
+// ScoreToken is used to score an individual token based on the traits' distribution across
+// the whole collection.
+func (c *TraitNormalizedScoringHandler) ScoreToken(collection models.ICollection, token models.IToken) (float64, error) {
+	return scoreTokensByAttributes(collection, []models.IToken{token}, true, weightedSum)[0], nil
+}
+
+// ScoreTokens should be used if you only want to score a batch of tokens that belong to collection.
+// This will typically be more efficient than calling score_token for each
+// token in `tokens`.
+func (c *TraitNormalizedScoringHandler) ScoreTokens(collection models.ICollection, tokens []models.IToken) ([]float64, error) {
+	return scoreTokensByAttributes(collection, tokens, true, weightedSum), nil
+}
+
+func weightedSum(scores []float64, weights []float64) float64 {
+	var result float64
+	for idx, score := range scores {
+		result += weights[idx] * score
+	}
+	return result
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+)
+
+// aggregateFunc is used to reduce the scores and weights of the attributes of a token to a single score
+type aggregateFunc func(scores []float64, weights []float64) float64
+
+// scoreTokensByAttributes is used to score every token by aggregating the inverse probability
+// scores and the weights of its attributes. Null attributes of the collection are only
+// extracted once for the whole batch.
+func scoreTokensByAttributes(
+	collection models.ICollection,
+	tokens []models.IToken,
+	normalized bool,
+	aggregate aggregateFunc,
+) []float64 {
+	collectionNullAttributes := collection.ExtractNullAttributes()
+	scores := make([]float64, 0, len(tokens))
+	for _, token := range tokens {
+		attrScores, attrWeights := scoring.GetTokenAttributesScoresAndWeights(
+			collection,
+			token,
+			normalized,
+			collectionNullAttributes,
+		)
+		scores = append(scores, aggregate(attrScores, attrWeights))
+	}
+	return scores
+}
+
+func sumFloat64(values []float64) float64 {
+	var result float64
+	for _, value := range values {
+		result += value
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+package scoring_test
+
+import (
+	"io"
+	"strings"
+
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("IO", func() {
+	contractAddress := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+	It("should read tokens from a wide CSV", func() {
+		document := "token_id,hat,level,token_standard\n" +
+			"1,cap,3,\n" +
+			"2,,5,erc1155\n" +
+			"\"3\",beanie,1,\n"
+		reader := oio.NewCSVReader(strings.NewReader(document),
+			oio.WithContractAddress(contractAddress),
+			oio.WithNumericColumns("level"),
+		)
+		var tokens []*models.Token
+		for {
+			token, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).To(BeNil())
+			tokens = append(tokens, token)
+		}
+		Expect(len(tokens)).To(Equal(3))
+		Expect(tokens[0].TokenIdentifier().String()).To(Equal("evm_contract:" + contractAddress + ":1"))
+		Expect(tokens[0].TokenStandard()).To(Equal(models.TokenStandardERC721))
+		Expect(tokens[0].Metadata().StringAttributes()["hat"]).To(Equal(models.NewStringAttribute("hat", "cap")))
+		Expect(tokens[0].Metadata().NumericAttributes()).To(HaveKey("level"))
+		Expect(tokens[1].HasAttribute("hat")).To(BeFalse())
+		Expect(tokens[1].TokenStandard()).To(Equal(models.TokenStandardERC1155))
+
+		collection, err := oio.ReadCSVCollection(strings.NewReader(document), "test",
+			oio.WithContractAddress(contractAddress),
+			oio.WithEmptyValues(),
+		)
+		Expect(err).To(BeNil())
+		Expect(collection.Tokens()[1].HasAttribute("hat")).To(BeTrue())
+		Expect(collection.Tokens()[1].Metadata().StringAttributes()["level"]).To(
+			Equal(models.NewStringAttribute("level", "5")),
+		)
+	})
+
+	It("should report the line of invalid CSV rows", func() {
+		reader := oio.NewCSVReader(
+			strings.NewReader("contract_address,token_id,hat\n" + contractAddress + ",1,cap\n,2,cap\n" + contractAddress + ",,cap\n"),
+		)
+		_, err := reader.Read()
+		Expect(err).To(BeNil())
+		_, err = reader.Read()
+		var recordErr *oio.RecordError
+		Expect(errors.As(err, &recordErr)).To(BeTrue())
+		Expect(recordErr.Line).To(Equal(3))
+		Expect(errors.Is(err, models.ErrInvalidEVMAddress)).To(BeTrue())
+		_, err = reader.Read()
+		Expect(errors.Is(err, oio.ErrMissingTokenIdentifier)).To(BeTrue())
+		_, err = reader.Read()
+		Expect(err).To(Equal(io.EOF))
+	})
+
+	It("should read tokens from JSON Lines", func() {
+		document := `{"token_identifier": "solana_mint_address:Fake-Address", "name": "Token #1", "image": "ipfs://1.png", "hat": "cap", "level": 3, "shiny": true}
+
+{"mint_address": "Other-Address", "attributes": [{"trait_type": "hat", "value": ""}, {"trait_type": "level", "value": 5, "display_type": "number"}]}
+{"mint_address": "Third-Address", "attributes": {"hat": "beanie", "level": 1, "birthday": "2022-01-01T00:00:00Z"}}
+`
+		collection, err := oio.ReadJSONLCollection(strings.NewReader(document), "test",
+			oio.WithDateColumns("birthday"),
+			oio.WithCollectionOptions(models.WithNumericBinner(models.NewCategoricalBinner())),
+		)
+		Expect(err).To(BeNil())
+		tokens := collection.Tokens()
+		Expect(len(tokens)).To(Equal(3))
+		Expect(tokens[0].TokenIdentifier()).To(Equal(models.NewSolanaMintAddressTokenIdentifier("Fake-Address")))
+		Expect(tokens[0].TokenStandard()).To(Equal(models.TokenStandardMetaplexNonFungible))
+		Expect(tokens[0].Metadata().StringAttributes()["shiny"]).To(Equal(models.NewStringAttribute("shiny", "true")))
+		Expect(tokens[0].Metadata().StringAttributes()["level"]).To(Equal(models.NewStringAttribute("level", "3")))
+		Expect(tokens[0].HasAttribute("name")).To(BeFalse())
+		Expect(tokens[0].HasAttribute("image")).To(BeFalse())
+		Expect(tokens[1].Metadata().NumericAttributes()).To(HaveKey("level"))
+		Expect(tokens[1].Metadata().NumericAttributes()).NotTo(HaveKey("hat"))
+		Expect(tokens[1].HasAttribute("hat")).To(BeFalse())
+		Expect(tokens[2].Metadata().DateAttributes()).To(HaveKey("birthday"))
+
+		// the dates are left unbinned
+		err = scoring.NewScorer(handlers.NewInformationContentScoringHandler()).ValidateCollection(collection)
+		Expect(errors.Is(err, scoring.ErrNumericTraitsUnsupported)).To(BeTrue())
+
+		_, err = oio.ReadJSONLCollection(strings.NewReader("{\"mint_address\": \"a\"}\n{"), "test")
+		var recordErr *oio.RecordError
+		Expect(errors.As(err, &recordErr)).To(BeTrue())
+		Expect(recordErr.Line).To(Equal(2))
+		Expect(errors.Is(err, models.ErrInvalidTokenMetadata)).To(BeTrue())
+	})
+
+	It("should reject records of tokens which were already read", func() {
+		reader := oio.NewCSVReader(strings.NewReader("contract_address,token_id,hat\n" +
+			contractAddress + ",1,cap\n" + contractAddress + ",2,cap\n" + contractAddress + ",1,beanie\n",
+		))
+		for idx := 0; idx < 2; idx++ {
+			_, err := reader.Read()
+			Expect(err).To(BeNil())
+		}
+		_, err := reader.Read()
+		var recordErr *oio.RecordError
+		Expect(errors.As(err, &recordErr)).To(BeTrue())
+		Expect(recordErr.Line).To(Equal(4))
+		Expect(errors.Is(err, oio.ErrDuplicateToken)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("already read at line 2"))
+
+		document := `{"mint_address": "Fake-Address", "hat": "cap"}` + "\n\n" +
+			`{"token_identifier": "solana_mint_address:Fake-Address", "hat": "beanie"}` + "\n"
+		_, err = oio.ReadJSONLCollection(strings.NewReader(document), "test")
+		Expect(errors.As(err, &recordErr)).To(BeTrue())
+		Expect(recordErr.Line).To(Equal(3))
+		Expect(errors.Is(err, oio.ErrDuplicateToken)).To(BeTrue())
+	})
+})
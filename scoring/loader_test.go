@@ -0,0 +1,168 @@
+package scoring_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Base-Labs/openrarity/loader"
+	"github.com/Base-Labs/openrarity/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("Loader", func() {
+	contractAddress := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	files := map[string]string{
+		"1.json":         `{"token_id": 11, "attributes": [{"trait_type": "Hat", "value": "Cap"}]}`,
+		"nested/2.json":  `{"token_id": "12", "attributes": [{"trait_type": "Hat", "value": "Beanie"}]}`,
+		"3":              `{"token_id": "0xd", "attributes": [{"trait_type": "Hat", "value": "Cap"}]}`,
+		"0x1.json":       `{"token_id": 11, "attributes": [{"trait_type": "Hat", "value": "Cap"}]}`,
+		"4.json":         `{"attributes": [`,
+		"1.png":          "not metadata",
+		".hidden/5.json": `{"attributes": []}`,
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tokenIDsOf := func(collection *models.Collection) []string {
+		var tokenIDs []string
+		for _, token := range collection.Tokens() {
+			tokenIDs = append(tokenIDs, token.TokenIdentifier().(models.EVMContractTokenIdentifier).TokenID().String())
+		}
+		return tokenIDs
+	}
+	expectReport := func(report *loader.Report) {
+		Expect(report.Loaded).To(Equal(3))
+		Expect(len(report.Errors)).To(Equal(2))
+		Expect(report.Errors[0].Path).To(Equal("1.json"))
+		Expect(errors.Is(report.Errors[0], loader.ErrDuplicateToken)).To(BeTrue())
+		Expect(report.Errors[1].Path).To(Equal("4.json"))
+		Expect(errors.Is(report.Errors[1], models.ErrInvalidTokenMetadata)).To(BeTrue())
+	}
+
+	It("should load a directory and report malformed files", func() {
+		dir := filepath.Join(GinkgoT().TempDir(), "tokens")
+		for _, name := range names {
+			Expect(os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(files[name]), 0o600)).To(Succeed())
+		}
+
+		collection, report, err := loader.Load(dir, loader.WithContractAddress(contractAddress))
+		Expect(err).To(BeNil())
+		expectReport(report)
+		Expect(collection.Name()).To(Equal("tokens"))
+		Expect(tokenIDsOf(collection)).To(Equal([]string{"1", "3", "2"}))
+
+		collection, report, err = loader.Load(dir,
+			loader.WithContractAddress(contractAddress),
+			loader.WithTokenIDField("token_id"),
+			loader.WithCollectionName("test"),
+		)
+		Expect(err).To(BeNil())
+		Expect(collection.Name()).To(Equal("test"))
+		Expect(tokenIDsOf(collection)).To(Equal([]string{"11", "13", "12"}))
+		Expect(report.Loaded).To(Equal(3))
+
+		_, _, err = loader.Load(dir)
+		Expect(errors.Is(err, loader.ErrMissingContractAddress)).To(BeTrue())
+	})
+
+	It("should load zip and tar archives", func() {
+		dir := GinkgoT().TempDir()
+
+		zipPath := filepath.Join(dir, "tokens.zip")
+		zipFile, err := os.Create(zipPath)
+		Expect(err).To(BeNil())
+		zipWriter := zip.NewWriter(zipFile)
+		for _, name := range names {
+			writer, err := zipWriter.Create(name)
+			Expect(err).To(BeNil())
+			_, err = writer.Write([]byte(files[name]))
+			Expect(err).To(BeNil())
+		}
+		Expect(zipWriter.Close()).To(Succeed())
+		Expect(zipFile.Close()).To(Succeed())
+
+		tarPath := filepath.Join(dir, "tokens.tar.gz")
+		tarFile, err := os.Create(tarPath)
+		Expect(err).To(BeNil())
+		gzipWriter := gzip.NewWriter(tarFile)
+		tarWriter := tar.NewWriter(gzipWriter)
+		for _, name := range names {
+			Expect(tarWriter.WriteHeader(&tar.Header{
+				Name:     "./" + name,
+				Mode:     0o600,
+				Size:     int64(len(files[name])),
+				Typeflag: tar.TypeReg,
+			})).To(Succeed())
+			_, err = tarWriter.Write([]byte(files[name]))
+			Expect(err).To(BeNil())
+		}
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzipWriter.Close()).To(Succeed())
+		Expect(tarFile.Close()).To(Succeed())
+
+		for _, archivePath := range []string{zipPath, tarPath} {
+			collection, report, err := loader.Load(archivePath, loader.WithContractAddress(contractAddress))
+			Expect(err).To(BeNil())
+			expectReport(report)
+			Expect(collection.Name()).To(Equal("tokens"))
+			Expect(tokenIDsOf(collection)).To(Equal([]string{"1", "3", "2"}))
+		}
+
+		textPath := filepath.Join(dir, "tokens.txt")
+		Expect(os.WriteFile(textPath, []byte("not an archive"), 0o600)).To(Succeed())
+		_, _, err = loader.Load(textPath, loader.WithContractAddress(contractAddress))
+		Expect(errors.Is(err, loader.ErrUnsupportedArchive)).To(BeTrue())
+	})
+
+	It("should report files which can't be read", func() {
+		dir := filepath.Join(GinkgoT().TempDir(), "tokens")
+		Expect(os.MkdirAll(dir, 0o700)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "1.json"), []byte(files["3"]), 0o600)).To(Succeed())
+		Expect(os.Symlink(filepath.Join(dir, "missing.json"), filepath.Join(dir, "2.json"))).To(Succeed())
+
+		collection, report, err := loader.Load(dir, loader.WithContractAddress(contractAddress))
+		Expect(err).To(BeNil())
+		Expect(tokenIDsOf(collection)).To(Equal([]string{"1"}))
+		Expect(report.Loaded).To(Equal(1))
+		Expect(len(report.Errors)).To(Equal(1))
+		Expect(report.Errors[0].Path).To(Equal("2.json"))
+		Expect(errors.Is(report.Errors[0], os.ErrNotExist)).To(BeTrue())
+
+		// the content of a stored entry is corrupted, so that its checksum doesn't match
+		zipPath := filepath.Join(GinkgoT().TempDir(), "tokens.zip")
+		zipFile, err := os.Create(zipPath)
+		Expect(err).To(BeNil())
+		zipWriter := zip.NewWriter(zipFile)
+		for _, name := range []string{"1.json", "2.json"} {
+			writer, err := zipWriter.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+			Expect(err).To(BeNil())
+			_, err = writer.Write([]byte(strings.Replace(files["3"], "0xd", name[:1], 1)))
+			Expect(err).To(BeNil())
+		}
+		Expect(zipWriter.Close()).To(Succeed())
+		Expect(zipFile.Close()).To(Succeed())
+		data, err := os.ReadFile(zipPath)
+		Expect(err).To(BeNil())
+		data[bytes.LastIndex(data, []byte("Cap"))] = 'X'
+		Expect(os.WriteFile(zipPath, data, 0o600)).To(Succeed())
+
+		collection, report, err = loader.Load(zipPath, loader.WithContractAddress(contractAddress))
+		Expect(err).To(BeNil())
+		Expect(tokenIDsOf(collection)).To(Equal([]string{"1"}))
+		Expect(len(report.Errors)).To(Equal(1))
+		Expect(report.Errors[0].Path).To(Equal("2.json"))
+		Expect(errors.Is(report.Errors[0], zip.ErrChecksum)).To(BeTrue())
+	})
+})
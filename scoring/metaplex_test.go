@@ -0,0 +1,80 @@
+package scoring_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Base-Labs/openrarity/loader"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("Metaplex", func() {
+	mintAddresses := []string{
+		"7xKXtg2CW87d97TXJSDpbD5jBkheTqA83TZRuJosgAsU",
+		"So11111111111111111111111111111111111111112",
+	}
+	documents := []string{
+		`{
+			"name": "Token #1",
+			"symbol": "TKN",
+			"seller_fee_basis_points": 500,
+			"attributes": [{"trait_type": "Hat", "value": "Cap"}, {"trait_type": "Level", "value": 3, "display_type": "number"}],
+			"properties": {"category": "image", "files": [{"uri": "1.png", "type": "image/png"}], "creators": [{"address": "x", "share": 100}]},
+			"collection": {"name": "Tokens", "family": "Token Family"}
+		}`,
+		`{"name": "Token #2", "attributes": [{"trait_type": "Hat", "value": "Beanie"}], "collection": {"family": "Token Family"}}`,
+	}
+
+	It("should build tokens from Metaplex JSON", func() {
+		token, err := models.NewMetaplexToken(mintAddresses[0], []byte(documents[0]))
+		Expect(err).To(BeNil())
+		Expect(token.TokenStandard()).To(Equal(models.TokenStandardMetaplexNonFungible))
+		Expect(token.TokenIdentifier()).To(Equal(models.NewSolanaMintAddressTokenIdentifier(mintAddresses[0])))
+		Expect(token.Metadata().StringAttributes()["hat"]).To(Equal(models.NewStringAttribute("hat", "cap")))
+		Expect(token.Metadata().NumericAttributes()).To(HaveKey("level"))
+
+		metadata, err := models.ParseMetaplexMetadata([]byte(documents[1]))
+		Expect(err).To(BeNil())
+		Expect(metadata.CollectionName()).To(Equal("Token Family"))
+
+		_, err = models.NewMetaplexToken("not-a-mint-address", []byte(documents[1]))
+		Expect(errors.Is(err, models.ErrInvalidTokenIdentifier)).To(BeTrue())
+		_, err = models.NewMetaplexToken(mintAddresses[0], []byte(`{"attributes": 1}`))
+		Expect(errors.Is(err, models.ErrInvalidTokenMetadata)).To(BeTrue())
+	})
+
+	It("should load a directory of Metaplex JSON files", func() {
+		dir := GinkgoT().TempDir()
+		for idx, mintAddress := range mintAddresses {
+			Expect(os.WriteFile(filepath.Join(dir, mintAddress+".json"), []byte(documents[idx]), 0o600)).To(Succeed())
+		}
+		Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("not metadata"), 0o600)).To(Succeed())
+
+		collection, report, err := loader.LoadMetaplexDir(dir,
+			loader.WithCollectionOptions(models.WithNumericBinner(models.NewCategoricalBinner())),
+		)
+		Expect(err).To(BeNil())
+		Expect(report.Loaded).To(Equal(2))
+		Expect(report.Errors).To(BeEmpty())
+		Expect(collection.Name()).To(Equal(filepath.Base(dir)))
+		Expect(collection.TokenTotalSupply()).To(Equal(2))
+		scores, err := scoring.NewScorer(handlers.NewInformationContentScoringHandler()).ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		Expect(len(scores)).To(Equal(2))
+
+		Expect(os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o600)).To(Succeed())
+		collection, report, err = loader.LoadMetaplexDir(dir, loader.WithCollectionName("Tokens"))
+		Expect(err).To(BeNil())
+		Expect(collection.Name()).To(Equal("Tokens"))
+		Expect(collection.TokenTotalSupply()).To(Equal(2))
+		Expect(report.Loaded).To(Equal(2))
+		Expect(len(report.Errors)).To(Equal(1))
+		Expect(report.Errors[0].Path).To(Equal("broken.json"))
+		Expect(errors.Is(report.Errors[0], models.ErrInvalidTokenMetadata)).To(BeTrue())
+	})
+})
@@ -0,0 +1,100 @@
+package scoring_test
+
+import (
+	"time"
+
+	"github.com/Base-Labs/openrarity/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("OpenSea Metadata", func() {
+	It("should convert attributes according to their display type", func() {
+		metadata, err := models.NewTokenMetadataFromOpenSeaJSON([]byte(`{
+			"name": "Token #1",
+			"image": "ipfs://image",
+			"attributes": [
+				{"trait_type": "Hat", "value": "Cap"},
+				{"trait_type": "Level", "value": 5, "display_type": "number"},
+				{"trait_type": "Power", "value": 1.5, "display_type": "boost_number"},
+				{"trait_type": "Stamina", "value": "10", "display_type": "boost_percentage"},
+				{"trait_type": "Birthday", "value": 1546360800, "display_type": "date"},
+				{"trait_type": "Minted At", "value": "2019-01-01T16:40:00Z", "display_type": "date"},
+				{"trait_type": "Generation", "value": 2},
+				{"trait_type": "Animated", "value": true},
+				{"trait_type": "Missing", "value": null},
+				{"value": "Cool"}
+			]
+		}`))
+		Expect(err).To(BeNil())
+
+		Expect(metadata.StringAttributes()).To(Equal(map[models.AttributeName]models.IStringAttribute{
+			"hat":                   models.NewStringAttribute("hat", "cap"),
+			"animated":              models.NewStringAttribute("animated", "true"),
+			models.UntypedTraitType: models.NewStringAttribute(models.UntypedTraitType, "cool"),
+		}))
+		numericValues := map[models.AttributeName]float64{}
+		for name, attribute := range metadata.NumericAttributes() {
+			numericValues[name] = models.NumericAttributeValueFloat64(attribute.Value())
+		}
+		Expect(numericValues).To(Equal(map[models.AttributeName]float64{
+			"level": 5, "power": 1.5, "stamina": 10, "generation": 2,
+		}))
+		level, ok := metadata.NumericAttributes()["level"].Value().Int64()
+		Expect(ok).To(BeTrue())
+		Expect(level).To(Equal(int64(5)))
+		Expect(metadata.DateAttributes()["birthday"].Value()).To(Equal(int64(1546360800)))
+		Expect(metadata.DateAttributes()["minted at"].Value()).To(
+			Equal(time.Date(2019, 1, 1, 16, 40, 0, 0, time.UTC).Unix()),
+		)
+	})
+
+	It("should merge duplicate trait types regardless of their order", func() {
+		for _, document := range []string{
+			`{"attributes": [{"trait_type": "Hat", "value": "Cap"}, {"trait_type": "hat", "value": "Beanie"}, {"value": "b"}, {"value": "a"}]}`,
+			`{"attributes": [{"value": "a"}, {"trait_type": "hat ", "value": "beanie"}, {"value": "b"}, {"trait_type": "HAT", "value": "cap"}]}`,
+		} {
+			metadata, err := models.NewTokenMetadataFromOpenSeaJSON([]byte(document))
+			Expect(err).To(BeNil())
+			Expect(metadata.StringAttributes()).To(Equal(map[models.AttributeName]models.IStringAttribute{
+				"hat":                   models.NewStringAttribute("hat", "beanie, cap"),
+				models.UntypedTraitType: models.NewStringAttribute(models.UntypedTraitType, "a, b"),
+			}))
+		}
+
+		metadata, err := models.NewTokenMetadataFromOpenSeaJSON([]byte(
+			`{"attributes": [{"trait_type": "Level", "value": 1, "display_type": "number"}, {"trait_type": "level", "value": 1}]}`,
+		))
+		Expect(err).To(BeNil())
+		Expect(metadata.NumericAttributes()).To(HaveKey("level"))
+
+		for _, document := range []string{
+			`{"attributes": [{"trait_type": "Power", "value": "5"}, {"trait_type": "power", "value": 5}]}`,
+			`{"attributes": [{"trait_type": "Power", "value": 5}, {"trait_type": "power", "value": "5"}]}`,
+		} {
+			metadata, err := models.NewTokenMetadataFromOpenSeaJSON([]byte(document))
+			Expect(err).To(BeNil())
+			Expect(metadata.StringAttributes()).To(Equal(map[models.AttributeName]models.IStringAttribute{
+				"power": models.NewStringAttribute("power", "5"),
+			}))
+			Expect(metadata.NumericAttributes()).To(BeEmpty())
+		}
+	})
+
+	It("should reject malformed documents", func() {
+		for _, document := range []string{
+			`{"attributes": {"hat": "cap"}}`,
+			`{"attributes": [{"trait_type": "Level", "value": "high", "display_type": "number"}]}`,
+			`{"attributes": [{"trait_type": "Birthday", "value": "yesterday", "display_type": "date"}]}`,
+		} {
+			_, err := models.NewTokenMetadataFromOpenSeaJSON([]byte(document))
+			Expect(errors.Is(err, models.ErrInvalidTokenMetadata)).To(BeTrue(), document)
+		}
+
+		_, err := models.NewTokenMetadataFromOpenSeaJSON([]byte(`{"attributes": [{"trait_type": "Hat", "value": ["cap"]}]}`))
+		var typeErr *models.InvalidAttributeTypeError
+		Expect(errors.As(err, &typeErr)).To(BeTrue())
+		Expect(typeErr.Name).To(Equal("hat"))
+	})
+})
@@ -0,0 +1,207 @@
+package scoring_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func newTokenRarities(scores ...float64) []models.ITokenRarity {
+	tokenRarities := make([]models.ITokenRarity, 0, len(scores))
+	for idx, score := range scores {
+		tokenRarities = append(tokenRarities, models.NewTokenRarity(
+			CreateEVMToken(idx, testContractAddress, models.TokenStandardERC721, nil),
+			score,
+			models.NewTokenRankingFeatures(0),
+		))
+	}
+	return tokenRarities
+}
+
+func ranksOf(tokenRarities []models.ITokenRarity) []int {
+	ranks := make([]int, 0, len(tokenRarities))
+	for _, tokenRarity := range tokenRarities {
+		ranks = append(ranks, tokenRarity.Rank())
+	}
+	return ranks
+}
+
+// baselineRanks is used to rank token rarities like earlier versions of SetRarityRanks,
+// which sorted by unique attribute count then score, and only compared scores for ties.
+func baselineRanks(tokenRarities []models.ITokenRarity) []int {
+	sorted := make([]models.ITokenRarity, len(tokenRarities))
+	copy(sorted, tokenRarities)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if delta := sorted[i].TokenFeatures().UniqueAttributeCount() -
+			sorted[j].TokenFeatures().UniqueAttributeCount(); delta != 0 {
+			return delta > 0
+		}
+		return sorted[i].Score() > sorted[j].Score()
+	})
+	ranks := make([]int, 0, len(sorted))
+	for idx, tokenRarity := range sorted {
+		rank := idx + 1
+		if idx > 0 && math.Abs(tokenRarity.Score()-sorted[idx-1].Score()) <= 1e-9 {
+			rank = ranks[idx-1]
+		}
+		ranks = append(ranks, rank)
+	}
+	return ranks
+}
+
+var _ = Describe("Rarity Ranker", func() {
+	It("should pass test_is_float64_close", func() {
+		Expect(openrarity.IsFloat64Close(1, 1+1e-10)).To(BeTrue())
+		Expect(openrarity.IsFloat64Close(1e10, 1e10+1)).To(BeTrue())
+		Expect(openrarity.IsFloat64Close(1e-10, 2e-10)).To(BeFalse())
+		Expect(openrarity.IsFloat64Close(math.Inf(1), math.Inf(1))).To(BeTrue())
+		Expect(openrarity.IsFloat64Close(math.Inf(1), math.MaxFloat64)).To(BeFalse())
+		Expect(openrarity.IsFloat64CloseWithTolerance(1e-10, 2e-10, 0, 1e-9)).To(BeTrue())
+		Expect(openrarity.IsFloat64CloseWithTolerance(1, 1.05, 0.1, 0)).To(BeTrue())
+	})
+
+	It("should rank tied tokens according to the ranking mode", func() {
+		scores := []float64{5, 4, 4, 4, 3}
+		tokenRarities, err := openrarity.NewRarityRanker().SetRarityRanks(newTokenRarities(scores...))
+		Expect(err).To(BeNil())
+		Expect(ranksOf(tokenRarities)).To(Equal([]int{1, 2, 2, 2, 5}))
+
+		tokenRarities, err = openrarity.NewRarityRanker(
+			openrarity.WithRankingMode(openrarity.RankingModeDenseRank),
+		).SetRarityRanks(newTokenRarities(scores...))
+		Expect(err).To(BeNil())
+		Expect(ranksOf(tokenRarities)).To(Equal([]int{1, 2, 2, 2, 3}))
+
+		tokenRarities, err = openrarity.NewRarityRanker(
+			openrarity.WithRankingMode(openrarity.RankingModeOrdinal),
+		).SetRarityRanks(newTokenRarities(scores...))
+		Expect(err).To(BeNil())
+		Expect(ranksOf(tokenRarities)).To(Equal([]int{1, 2, 3, 4, 5}))
+
+		_, err = openrarity.NewRarityRanker(
+			openrarity.WithRankingMode("unknown"),
+		).SetRarityRanks(newTokenRarities(scores...))
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should consider scores within the tolerance as tied", func() {
+		tokenRarities, err := openrarity.NewRarityRanker(
+			openrarity.WithTolerance(0, 0.5),
+		).SetRarityRanks(newTokenRarities(3, 2.8, 1))
+		Expect(err).To(BeNil())
+		Expect(ranksOf(tokenRarities)).To(Equal([]int{1, 1, 3}))
+	})
+
+	It("should only tie near-zero scores within the absolute tolerance", func() {
+		scores := []float64{0, 1e-12, 0, 5e-10}
+		tokenRarities, err := openrarity.NewRarityRanker().SetRarityRanks(newTokenRarities(scores...))
+		Expect(err).To(BeNil())
+		Expect(ranksOf(tokenRarities)).To(Equal([]int{1, 2, 3, 3}))
+
+		// the fixed absolute tolerance of earlier versions ties every score below 1e-9
+		tokenRarities, err = openrarity.NewRarityRanker(
+			openrarity.WithTolerance(0, 1e-9),
+		).SetRarityRanks(newTokenRarities(scores...))
+		Expect(err).To(BeNil())
+		Expect(ranksOf(tokenRarities)).To(Equal([]int{1, 1, 1, 1}))
+
+		collection, err := GenerateCollectionWithTokenTraits(
+			[]map[string]interface{}{{"hat": "cap"}, {"hat": "cap"}, {"hat": "cap"}},
+			models.IdentifierTypeEVMContract,
+		)
+		Expect(err).To(BeNil())
+		tokenRarities, err = openrarity.NewRarityRanker().RankCollection(collection, openrarity.NewOpenRarityScorer())
+		Expect(err).To(BeNil())
+		Expect(ranksOf(tokenRarities)).To(Equal([]int{1, 1, 1}))
+	})
+
+	It("should only tie tokens equal on every sort key", func() {
+		tokenRarities := newTokenRarities(4, 3, 3, 3, 2, 1)
+		tokenRarities[4] = models.NewTokenRarity(tokenRarities[4].Token(), 2, models.NewTokenRankingFeatures(1))
+		ranker := openrarity.NewRarityRanker(openrarity.WithTolerance(0, 1e-9))
+		ranked, err := ranker.SetRarityRanks(tokenRarities)
+		Expect(err).To(BeNil())
+		Expect(ranksOf(ranked)).To(Equal([]int{1, 2, 3, 3, 3, 6}))
+		Expect(ranksOf(ranked)).To(Equal(baselineRanks(tokenRarities)))
+
+		// earlier versions tied the tokens with the same score, even though the sort
+		// put the token with a unique attribute first
+		tokenRarities = newTokenRarities(2, 2, 1)
+		tokenRarities[1] = models.NewTokenRarity(tokenRarities[1].Token(), 2, models.NewTokenRankingFeatures(1))
+		Expect(baselineRanks(tokenRarities)).To(Equal([]int{1, 1, 3}))
+		ranked, err = ranker.SetRarityRanks(tokenRarities)
+		Expect(err).To(BeNil())
+		Expect(ranksOf(ranked)).To(Equal([]int{1, 2, 3}))
+		Expect(ranked[0].Token()).To(Equal(tokenRarities[1].Token()))
+	})
+
+	It("should sort tokens by the configured sort keys", func() {
+		tokenRarities := newTokenRarities(3, 2, 1)
+		tokenRarities[2] = models.NewTokenRarity(
+			tokenRarities[2].Token(), 1, models.NewTokenRankingFeatures(1),
+		)
+		ranked, err := openrarity.NewRarityRanker().SetRarityRanks(tokenRarities)
+		Expect(err).To(BeNil())
+		Expect(ranked[0].Score()).To(Equal(1.0))
+		Expect(ranksOf(ranked)).To(Equal([]int{1, 2, 3}))
+
+		ranked, err = openrarity.NewRarityRanker(
+			openrarity.WithSortKeys(openrarity.SortByScore(true)),
+		).SetRarityRanks(ranked)
+		Expect(err).To(BeNil())
+		Expect(ranked[0].Score()).To(Equal(3.0))
+
+		ranked, err = openrarity.NewRarityRanker(
+			openrarity.WithSortKeys(openrarity.SortByScore(false)),
+		).SetRarityRanks(ranked)
+		Expect(err).To(BeNil())
+		Expect(ranked[0].Score()).To(Equal(1.0))
+
+		ranked, err = openrarity.NewRarityRanker(
+			openrarity.WithSortKeys(openrarity.SortByTraitCount(true)),
+		).SetRarityRanks(ranked)
+		Expect(err).To(BeNil())
+		Expect(ranksOf(ranked)).To(Equal([]int{1, 1, 1}))
+
+		ranked, err = openrarity.NewRarityRanker(
+			openrarity.WithSortKeys(openrarity.NewSortKey("negated_score", func(tokenRarity models.ITokenRarity) float64 {
+				return -tokenRarity.Score()
+			}, true)),
+		).SetRarityRanks(ranked)
+		Expect(err).To(BeNil())
+		Expect(ranked[0].Score()).To(Equal(1.0))
+	})
+
+	It("should rank tied tokens in a deterministic order", func() {
+		tokenRarities := newTokenRarities(1, 2, 2, 2, 2, 3)
+		shuffled := make([]models.ITokenRarity, len(tokenRarities))
+		copy(shuffled, tokenRarities)
+		rand.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		inputOrder := make([]models.ITokenRarity, len(shuffled))
+		copy(inputOrder, shuffled)
+
+		for _, mode := range []openrarity.RankingMode{
+			openrarity.RankingModeRank,
+			openrarity.RankingModeDenseRank,
+			openrarity.RankingModeOrdinal,
+		} {
+			ranker := openrarity.NewRarityRanker(openrarity.WithRankingMode(mode))
+			expected, err := ranker.SetRarityRanks(tokenRarities)
+			Expect(err).To(BeNil())
+			ranked, err := ranker.SetRarityRanks(shuffled)
+			Expect(err).To(BeNil())
+			Expect(ranked).To(Equal(expected))
+			Expect(shuffled).To(Equal(inputOrder))
+			for idx, tokenRarity := range ranked[1:5] {
+				Expect(tokenRarity.Token()).To(Equal(tokenRarities[idx+1].Token()))
+			}
+		}
+	})
+})
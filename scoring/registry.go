@@ -0,0 +1,51 @@
+package scoring
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ScoreHandlerFactory is used to build a new score handler
+type ScoreHandlerFactory func() IScoreHandler
+
+var (
+	scoreHandlersMu sync.RWMutex
+	scoreHandlers   = map[string]ScoreHandlerFactory{}
+)
+
+// RegisterScoreHandler makes a score handler available under the given name.
+// It is meant to be called from the init function of the package implementing the handler,
+// and panics if the name is registered twice or if the factory is nil.
+func RegisterScoreHandler(name string, factory ScoreHandlerFactory) {
+	scoreHandlersMu.Lock()
+	defer scoreHandlersMu.Unlock()
+	if factory == nil {
+		panic("scoring: RegisterScoreHandler factory is nil")
+	}
+	if _, exists := scoreHandlers[name]; exists {
+		panic("scoring: RegisterScoreHandler called twice for handler " + name)
+	}
+	scoreHandlers[name] = factory
+}
+
+// NewScoreHandler is used to build the score handler registered under the given name
+func NewScoreHandler(name string) (IScoreHandler, error) {
+	scoreHandlersMu.RLock()
+	factory, exists := scoreHandlers[name]
+	scoreHandlersMu.RUnlock()
+	if !exists {
+		return nil, errors.Wrapf(ErrUnknownScoreHandler, "%q", name)
+	}
+	return factory(), nil
+}
+
+// ScoreHandlerNames is used to get the sorted names of all registered score handlers
+func ScoreHandlerNames() []string {
+	scoreHandlersMu.RLock()
+	defer scoreHandlersMu.RUnlock()
+	names := GetMapKeys(scoreHandlers)
+	sort.Strings(names)
+	return names
+}
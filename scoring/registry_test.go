@@ -0,0 +1,71 @@
+package scoring_test
+
+import (
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+type constantScoringHandler struct{}
+
+func (c *constantScoringHandler) ScoreToken(models.ICollection, models.IToken) (float64, error) {
+	return 1, nil
+}
+
+func (c *constantScoringHandler) ScoreTokens(_ models.ICollection, tokens []models.IToken) ([]float64, error) {
+	scores := make([]float64, len(tokens))
+	for i := range scores {
+		scores[i] = 1
+	}
+	return scores, nil
+}
+
+var _ = Describe("Score Handler Registry", func() {
+	scoring.RegisterScoreHandler("constant", func() scoring.IScoreHandler {
+		return &constantScoringHandler{}
+	})
+
+	It("should build scorers by name", func() {
+		Expect(scoring.ScoreHandlerNames()).To(ContainElements(
+			handlers.InformationContentHandlerName,
+			handlers.HarmonicMeanHandlerName,
+			handlers.TraitNormalizedHandlerName,
+			"constant",
+		))
+
+		collection, err := GenerateCollectionWithTokenTraits(
+			[]map[string]interface{}{
+				{"bottom": "1", "hat": "1"},
+				{"bottom": "1", "hat": "2"},
+			},
+			models.IdentifierTypeEVMContract,
+		)
+		Expect(err).To(BeNil())
+
+		scorer, err := openrarity.NewScorerByName(handlers.InformationContentHandlerName)
+		Expect(err).To(BeNil())
+		scores, err := scorer.ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		expectedScores, err := openrarity.NewOpenRarityScorer().ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		Expect(scores).To(Equal(expectedScores))
+
+		scorer, err = openrarity.NewScorerByName("constant", scoring.WithValidators())
+		Expect(err).To(BeNil())
+		scores, err = scorer.ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		Expect(scores).To(Equal([]float64{1, 1}))
+
+		_, err = openrarity.NewScorerByName("unknown")
+		Expect(errors.Is(err, openrarity.ErrUnknownScoreHandler)).To(BeTrue())
+		Expect(func() {
+			scoring.RegisterScoreHandler("constant", func() scoring.IScoreHandler {
+				return &constantScoringHandler{}
+			})
+		}).To(Panic())
+	})
+})
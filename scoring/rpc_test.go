@@ -0,0 +1,251 @@
+package scoring_test
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/Base-Labs/openrarity"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/rpc"
+	"github.com/Base-Labs/openrarity/rpc/openrarityv1"
+	"github.com/Base-Labs/openrarity/scoring"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("RPC", func() {
+	contractAddress := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+	newCollection := func() *models.Collection {
+		traits := []map[string]interface{}{
+			{"background": "red", "hat": "cap"},
+			{"background": "red"},
+			{"background": "blue", "hat": "crown"},
+			{"background": "red", "hat": "cap"},
+			{"background": "green", "hat": "cap"},
+		}
+		tokens := make([]models.IToken, 0, len(traits))
+		for idx, metadata := range traits {
+			tokens = append(tokens, must(models.NewERC721Token(contractAddress, idx+1, metadata)))
+		}
+		return must(models.NewCollection("drop", tokens))
+	}
+
+	dial := func(opts ...rpc.ServiceOption) *rpc.Client {
+		listener := bufconn.Listen(1 << 20)
+		server := grpc.NewServer()
+		rpc.NewService(opts...).Register(server)
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		conn := must(grpc.Dial("bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		))
+		DeferCleanup(func() {
+			_ = conn.Close()
+			server.Stop()
+		})
+		return rpc.NewClient(conn)
+	}
+
+	var client *rpc.Client
+	BeforeEach(func() {
+		client = dial()
+	})
+
+	It("should convert identifiers of every type", func() {
+		identifiers := []models.ITokenIdentifier{
+			must(models.NewEVMContractTokenIdentifierFromBigInt(contractAddress,
+				new(big.Int).Lsh(big.NewInt(1), 200),
+			)),
+			models.NewSolanaMintAddressTokenIdentifier("So11111111111111111111111111111111111111112"),
+			must(models.ParseTezosFA2TokenIdentifier("KT1RJ6PbjHpwc3M5rw5s2Nbmefwbuwbdxton", "7")),
+			must(models.NewOrdinalsInscriptionTokenIdentifier(
+				"6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799i0",
+			)),
+			must(models.NewAptosObjectTokenIdentifier("0x1")),
+			must(models.NewSuiObjectTokenIdentifier("0x2")),
+			must(models.NewCosmosCW721TokenIdentifier("abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", "a:1")),
+		}
+		for _, identifier := range identifiers {
+			message := must(rpc.TokenIdentifierToProto(identifier))
+			converted := must(rpc.TokenIdentifierFromProto(message))
+			Expect(converted.Equal(identifier)).To(BeTrue(), identifier.String())
+		}
+
+		token := models.NewToken(identifiers[1], models.TokenStandardMetaplexNonFungible,
+			must(models.NewTokenMetadataFromAttributes(map[string]interface{}{
+				"hat":   "cap",
+				"level": 3,
+				"speed": 1.5,
+				"born":  time.Unix(1700000000, 0),
+			})),
+		)
+		converted := must(rpc.TokenFromProto(must(rpc.TokenToProto(token))))
+		Expect(converted.TokenIdentifier().Equal(token.TokenIdentifier())).To(BeTrue())
+		Expect(converted.TokenStandard()).To(Equal(token.TokenStandard()))
+		Expect(converted.Metadata().StringAttributes()).To(Equal(token.Metadata().StringAttributes()))
+		level, _ := converted.Metadata().NumericAttributes()["level"].Value().Int64()
+		Expect(level).To(Equal(int64(3)))
+		speed, _ := converted.Metadata().NumericAttributes()["speed"].Value().Float64()
+		Expect(speed).To(Equal(1.5))
+		Expect(converted.Metadata().DateAttributes()).To(Equal(token.Metadata().DateAttributes()))
+
+		_, err := rpc.TokenIdentifierFromProto(nil)
+		Expect(err).To(MatchError(models.ErrInvalidTokenIdentifier))
+	})
+
+	It("should reject attribute names used by several attribute types", func() {
+		message := &openrarityv1.TokenMetadata{
+			StringAttributes: map[string]string{"level": "high"},
+			NumericAttributes: map[string]*openrarityv1.NumericValue{
+				"Level": {Value: &openrarityv1.NumericValue_IntValue{IntValue: 3}},
+			},
+		}
+		_, err := rpc.TokenMetadataFromProto(message)
+		Expect(err).To(MatchError(rpc.ErrInvalidMessage))
+
+		message = &openrarityv1.TokenMetadata{
+			StringAttributes: map[string]string{"hat": "cap"},
+			DateAttributes:   map[string]int64{"hat": 1700000000},
+		}
+		_, err = rpc.TokenMetadataFromProto(message)
+		Expect(err).To(MatchError(rpc.ErrInvalidMessage))
+	})
+
+	It("should not share the features of ranked tokens with their messages", func() {
+		collection := newCollection()
+		tokenRarities := must(openrarity.NewRarityRanker().RankCollection(collection, openrarity.NewOpenRarityScorer()))
+		features := tokenRarities[0].TokenFeatures().Features()
+		message := must(rpc.TokenRarityToProto(tokenRarities[0]))
+		Expect(message.Features.Features).To(Equal(features))
+		message.Features.Features[models.UniqueAttributeCountFeatureName] = 42
+		Expect(tokenRarities[0].TokenFeatures().Features()).To(Equal(features))
+	})
+
+	It("should score and rank collections like the local scorer and ranker", func() {
+		ctx := context.Background()
+		collection := newCollection()
+		scorer := openrarity.NewOpenRarityScorer()
+		ranker := openrarity.NewRarityRanker(openrarity.WithRankingMode(openrarity.RankingModeDenseRank))
+		expected := must(ranker.RankCollection(collection, scorer))
+
+		scores := must(client.ScoreCollection(ctx, collection))
+		for idx, score := range must(scorer.ScoreCollection(collection)) {
+			Expect(scores[idx]).To(Equal(score))
+		}
+
+		for _, tokenRarities := range [][]models.ITokenRarity{
+			must(client.RankCollection(ctx, collection, rpc.WithRankingMode(openrarity.RankingModeDenseRank))),
+			must(client.RankCollectionStream(ctx, collection,
+				rpc.WithRankingMode(openrarity.RankingModeDenseRank),
+				rpc.WithChunkSize(2),
+			)),
+		} {
+			Expect(ranksOf(tokenRarities)).To(Equal(ranksOf(expected)))
+			for idx, tokenRarity := range tokenRarities {
+				Expect(tokenRarity.Token().TokenIdentifier().Equal(expected[idx].Token().TokenIdentifier())).To(BeTrue())
+				Expect(tokenRarity.Score()).To(Equal(expected[idx].Score()))
+				Expect(tokenRarity.TokenFeatures().UniqueAttributeCount()).To(
+					Equal(expected[idx].TokenFeatures().UniqueAttributeCount()),
+				)
+			}
+		}
+
+		token := collection.Tokens()[1]
+		explanation := must(client.ExplainToken(ctx, collection, token))
+		expectedExplanation := must(scorer.(scoring.ITokenExplainer).ExplainToken(collection, token))
+		Expect(explanation.Token).To(Equal(token))
+		Expect(explanation.Score).To(Equal(expectedExplanation.Score))
+		Expect(len(explanation.Attributes)).To(Equal(len(expectedExplanation.Attributes)))
+		for idx, attribute := range explanation.Attributes {
+			Expect(attribute.Name).To(Equal(expectedExplanation.Attributes[idx].Name))
+			Expect(attribute.Value).To(Equal(expectedExplanation.Attributes[idx].Value))
+			Expect(attribute.TotalTokens).To(Equal(expectedExplanation.Attributes[idx].TotalTokens))
+			Expect(attribute.IsNull).To(Equal(expectedExplanation.Attributes[idx].IsNull))
+		}
+	})
+
+	It("should score and rank binned collections like the local scorer and ranker", func() {
+		ctx := context.Background()
+		binner := models.WithNumericBinner(models.NewEdgesBinner(10))
+		traits := []map[string]interface{}{
+			{"hat": "cap", "level": 1},
+			{"hat": "cap", "level": 2},
+			{"hat": "crown", "level": 3},
+			{"hat": "cap", "level": 99},
+		}
+		tokens := make([]models.IToken, 0, len(traits))
+		for idx, metadata := range traits {
+			tokens = append(tokens, must(models.NewERC721Token(contractAddress, idx+1, metadata)))
+		}
+		collection := must(models.NewCollection("binned", tokens, binner))
+		scorer := openrarity.NewOpenRarityScorer()
+		expected := must(openrarity.NewRarityRanker().RankCollection(collection, scorer))
+
+		message := rpc.TokenMetadataToProto(collection.Tokens()[0].Metadata())
+		Expect(message.StringAttributes).NotTo(HaveKey("level"))
+		Expect(message.NumericAttributes).To(HaveKey("level"))
+
+		binningClient := dial(rpc.WithCollectionOptions(binner))
+		scores := must(binningClient.ScoreCollection(ctx, collection))
+		for idx, score := range must(scorer.ScoreCollection(collection)) {
+			Expect(scores[idx]).To(Equal(score))
+		}
+		for _, tokenRarities := range [][]models.ITokenRarity{
+			must(binningClient.RankCollection(ctx, collection)),
+			must(binningClient.RankCollectionStream(ctx, collection, rpc.WithChunkSize(3))),
+		} {
+			Expect(ranksOf(tokenRarities)).To(Equal(ranksOf(expected)))
+			for idx, tokenRarity := range tokenRarities {
+				Expect(tokenRarity.Token().TokenIdentifier().Equal(expected[idx].Token().TokenIdentifier())).To(BeTrue())
+				Expect(tokenRarity.Score()).To(Equal(expected[idx].Score()))
+			}
+		}
+		explanation := must(binningClient.ExplainToken(ctx, collection, collection.Tokens()[3]))
+		Expect(explanation.Score).To(Equal(expected[0].Score()))
+
+		// the service without binner can't score the numeric attributes
+		_, err := client.ScoreCollection(ctx, collection)
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+	})
+
+	It("should rank ERC1155 collections with their supplies", func() {
+		ctx := context.Background()
+		collection := newCollection()
+		erc1155Collection := must(models.NewERC1155Collection("editions", collection.Tokens(), []int{1, 5, 1, 1, 2}))
+		expected := must(openrarity.NewRarityRanker().RankCollection(erc1155Collection, openrarity.NewOpenRarityScorer()))
+		tokenRarities := must(client.RankCollectionStream(ctx, erc1155Collection, rpc.WithChunkSize(3)))
+		Expect(ranksOf(tokenRarities)).To(Equal(ranksOf(expected)))
+		Expect(tokenRarities[0].Score()).To(Equal(expected[0].Score()))
+	})
+
+	It("should report errors with gRPC status codes", func() {
+		ctx := context.Background()
+		collection := newCollection()
+
+		_, err := client.RankCollection(ctx, collection, rpc.WithHandler("nope"))
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = client.RankCollection(ctx, collection, rpc.WithRankingMode("nope"))
+		Expect(err).To(MatchError(openrarity.ErrUnknownRankingMode))
+
+		token := must(models.NewERC721Token(contractAddress, 9, map[string]interface{}{"level": 1}))
+		numericCollection := must(models.NewCollection("numeric", []models.IToken{token}))
+		_, err = client.ScoreCollection(ctx, numericCollection)
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+		_, err = client.ExplainToken(ctx, collection, token)
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+})
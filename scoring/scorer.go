@@ -2,12 +2,14 @@ package scoring
 
 import (
 	"github.com/Base-Labs/openrarity/models"
-	"github.com/pkg/errors"
 )
 
 // IScorer is the main class to score rarity scores for a given
 // collection and token(s) based on the default OpenRarity scoring
 // algorithm.
+//
+// Scorers able to explain how the score of a token is computed, such as Scorer,
+// also implement ITokenExplainer.
 type IScorer interface {
 	IScoreHandler
 	// ValidateCollection is used to validate collection eligibility for OpenRarity scoring
@@ -35,30 +37,45 @@ type IScoreHandler interface {
 // collection and token(s) based on the default OpenRarity scoring
 // algorithm.
 type Scorer struct {
-	handler IScoreHandler
+	handler    IScoreHandler
+	validators []IValidator
 }
 
 var _ IScorer = &Scorer{}
+var _ ITokenExplainer = &Scorer{}
+
+// ScorerOption is used to configure the optional behaviours of Scorer
+type ScorerOption func(c *Scorer)
+
+// WithValidators is used to replace the default validators deciding the collection
+// eligibility for scoring. Passing no validator disables the validation.
+func WithValidators(validators ...IValidator) ScorerOption {
+	return func(c *Scorer) {
+		c.validators = validators
+	}
+}
 
 // NewScorer is the constructor of Scorer
-func NewScorer(handler IScoreHandler) *Scorer {
-	return &Scorer{
-		handler: handler,
+func NewScorer(handler IScoreHandler, opts ...ScorerOption) *Scorer {
+	c := &Scorer{
+		handler:    handler,
+		validators: DefaultValidators(),
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
-// ValidateCollection is used to validate collection eligibility for OpenRarity scoring
+// ValidateCollection is used to validate collection eligibility for OpenRarity scoring.
+// The returned error is a *ValidationReport listing the violations of every validator.
 func (c *Scorer) ValidateCollection(collection models.ICollection) error {
-	if collection.HasNumericAttribute() {
-		return errors.New("OpenRarity currently does not support collections with " +
-			"numeric or date traits")
-	}
-	allowedStandards := []models.TokenStandard{
-		models.TokenStandardERC721,
-		models.TokenStandardMetaplexNonFungible,
+	report := &ValidationReport{}
+	for _, validator := range c.validators {
+		report.Violations = append(report.Violations, validator.Validate(collection)...)
 	}
-	if !models.IsSubset(allowedStandards, collection.TokenStandards()) {
-		return errors.New("OpenRarity currently only supports ERC721/Non-fungible standards")
+	if len(report.Violations) > 0 {
+		return report
 	}
 	return nil
 }
@@ -82,6 +99,19 @@ func (c *Scorer) ScoreTokens(collection models.ICollection, tokens []models.ITok
 	return c.handler.ScoreTokens(collection, tokens)
 }
 
+// ExplainToken is used to break down the score of a token into the contribution
+// of each of its attributes. It fails if the handler doesn't implement ITokenExplainer.
+func (c *Scorer) ExplainToken(collection models.ICollection, token models.IToken) (*TokenExplanation, error) {
+	if err := c.ValidateCollection(collection); err != nil {
+		return nil, err
+	}
+	explainer, ok := c.handler.(ITokenExplainer)
+	if !ok {
+		return nil, ErrExplanationUnsupported
+	}
+	return explainer.ExplainToken(collection, token)
+}
+
 // ScoreCollection is used to score all tokens on collection.tokens
 func (c *Scorer) ScoreCollection(collection models.ICollection) ([]float64, error) {
 	if err := c.ValidateCollection(collection); err != nil {
@@ -5,6 +5,7 @@ import (
 	"github.com/Base-Labs/openrarity/models"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 )
 
 var _ = Describe("Scoring", func() {
@@ -68,23 +69,65 @@ var _ = Describe("Scoring", func() {
 		Expect(err).NotTo(BeNil())
 		Expect(err.Error()).To(ContainSubstring("OpenRarity currently does not support collections " +
 			"with numeric or date traits"))
+		Expect(errors.Is(err, openrarity.ErrNumericTraitsUnsupported)).To(BeTrue())
+		Expect(errors.Is(err, openrarity.ErrUnsupportedStandard)).To(BeFalse())
 	})
 	It("should pass test_score_collection_with_erc1155_errors", func() {
 		tokens := make([]openrarity.IToken, 0, 10)
 		for i := 0; i < 10; i++ {
 			tokens = append(tokens, CreateEVMToken(
 				i,
-				"0xaaa",
+				testContractAddress,
 				models.TokenStandardERC1155,
 				nil,
 			))
 		}
-		collection := openrarity.NewCollection("test", tokens)
+		collection := must(openrarity.NewCollection("test", tokens))
 		scorer := openrarity.NewOpenRarityScorer()
 		scores, err := scorer.ScoreCollection(collection)
 		Expect(scores).To(BeNil())
 		Expect(err).NotTo(BeNil())
 		Expect(err.Error()).To(ContainSubstring("OpenRarity currently only supports " +
-			"ERC721/Non-fungible standards"))
+			"non-fungible token standards"))
+		Expect(err.Error()).To(ContainSubstring("collection holds erc1155 tokens, allowed standards are erc721, "))
+		Expect(errors.Is(err, openrarity.ErrUnsupportedStandard)).To(BeTrue())
+	})
+	It("should pass test_invalid_attribute_type_errors", func() {
+		_, err := models.NewTokenMetadataFromAttributes(map[string]interface{}{
+			"hat":  "cap",
+			"Tags": []string{"a"},
+		})
+		var attrErr *openrarity.InvalidAttributeTypeError
+		Expect(errors.As(err, &attrErr)).To(BeTrue())
+		Expect(attrErr.Name).To(Equal("tags"))
+		Expect(attrErr.Type.String()).To(Equal("[]string"))
+	})
+	It("should score erc1155 collections weighted by supply", func() {
+		tokens := make([]openrarity.IToken, 0, 3)
+		for idx, hat := range []string{"cap", "cap", "visor"} {
+			tokens = append(tokens, CreateEVMToken(
+				idx,
+				testContractAddress,
+				models.TokenStandardERC1155,
+				must(models.NewTokenMetadataFromAttributes(map[string]interface{}{"hat": hat})),
+			))
+		}
+		collection, err := openrarity.NewERC1155Collection("test", tokens, []int{10, 5, 1})
+		Expect(err).To(BeNil())
+		Expect(collection.TokenTotalSupply()).To(Equal(16))
+		Expect(collection.TotalTokensWithAttributes(models.NewStringAttribute("hat", "cap"))).To(Equal(15))
+		collection.TokenSupplies()[0] = 0
+		Expect(collection.TokenSupplies()).To(Equal([]int{10, 5, 1}))
+
+		tokenRarities, err := openrarity.NewRarityRanker().RankCollection(
+			collection,
+			openrarity.NewOpenRarityScorer(),
+		)
+		Expect(err).To(BeNil())
+		Expect(len(tokenRarities)).To(Equal(3))
+		Expect(tokenRarities[0].Token()).To(Equal(tokens[2]))
+
+		_, err = openrarity.NewERC1155Collection("test", tokens, []int{1, 1})
+		Expect(err).NotTo(BeNil())
 	})
 })
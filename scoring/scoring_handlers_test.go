@@ -5,6 +5,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/Base-Labs/openrarity"
 	"github.com/Base-Labs/openrarity/models"
 	"github.com/Base-Labs/openrarity/scoring"
 	"github.com/Base-Labs/openrarity/scoring/handlers"
@@ -16,10 +17,10 @@ var _ = Describe("Scoring Handlers", func() {
 	maxScoringTimeFor10ks := 2
 
 	uniformTokens := UniformRarityTokens(10000, 5, 10)
-	uniformCollection := models.NewCollection("", uniformTokens)
+	uniformCollection := must(models.NewCollection("", uniformTokens))
 
 	oneRareTokens := OneRareRarityTokens(10000, 3, 10)
-	oneRareCollection := models.NewCollection("", oneRareTokens)
+	oneRareCollection := must(models.NewCollection("", oneRareTokens))
 
 	mixedCollection, err := GenerateMixedCollection(10000)
 	Expect(err).To(BeNil())
@@ -33,6 +34,17 @@ var _ = Describe("Scoring Handlers", func() {
 		Expect(int(math.Round(score0*1e8) / 1e8)).To(Equal(uniformIcRarity))
 	})
 
+	It("should compute the same collection entropy and scores on every run", func() {
+		icHandler := handlers.NewInformationContentScoringHandler()
+		entropy := icHandler.GetCollectionEntropy(mixedCollection, nil, nil)
+		scores, err := icHandler.ScoreTokens(mixedCollection, mixedCollection.Tokens()[:100])
+		Expect(err).To(BeNil())
+		for i := 0; i < 20; i++ {
+			Expect(icHandler.GetCollectionEntropy(mixedCollection, nil, nil)).To(Equal(entropy))
+			Expect(icHandler.ScoreTokens(mixedCollection, mixedCollection.Tokens()[:100])).To(Equal(scores))
+		}
+	})
+
 	It("should able to pass test_information_content_null_value_attribute", func() {
 		icScorer := handlers.NewInformationContentScoringHandler()
 		collectionWithEmpty, err := GenerateCollectionWithTokenTraits(
@@ -154,5 +166,78 @@ var _ = Describe("Scoring Handlers", func() {
 		Expect(toc.Sub(tic).Seconds() < float64(maxScoringTimeFor10ks)).To(BeTrue())
 	})
 
+	It("should able to pass test_mean_and_sum_rarity", func() {
+		collection, err := GenerateCollectionWithTokenTraits(
+			[]map[string]interface{}{
+				{"bottom": "1", "hat": "1", "special": "true"},
+				{"bottom": "1", "hat": "1"},
+				{"bottom": "2", "hat": "2"},
+				{"bottom": "2", "hat": "2"},
+				{"bottom": "3", "hat": "2"},
+			},
+			models.IdentifierTypeEVMContract,
+		)
+		Expect(err).To(BeNil())
+
+		arithmeticScores, err := handlers.NewArithmeticMeanScoringHandler().ScoreTokens(collection, collection.Tokens())
+		Expect(err).To(BeNil())
+		geometricScores, err := handlers.NewGeometricMeanScoringHandler().ScoreTokens(collection, collection.Tokens())
+		Expect(err).To(BeNil())
+		harmonicScores, err := handlers.NewHarmonicMeanScoringHandler().ScoreTokens(collection, collection.Tokens())
+		Expect(err).To(BeNil())
+		sumScores, err := handlers.NewSumScoringHandler().ScoreTokens(collection, collection.Tokens())
+		Expect(err).To(BeNil())
+
+		for i, token := range collection.Tokens() {
+			attrScores, _ := scoring.GetTokenAttributesScoresAndWeights(collection, token, false, nil)
+			var sum, logSum, inverseSum float64
+			for _, score := range attrScores {
+				sum += score
+				logSum += math.Log(score)
+				inverseSum += 1 / score
+			}
+			count := float64(len(attrScores))
+			Expect(arithmeticScores[i]).To(BeNumerically("~", sum/count, 1e-9))
+			Expect(geometricScores[i]).To(BeNumerically("~", math.Exp(logSum/count), 1e-9))
+			Expect(harmonicScores[i]).To(BeNumerically("~", count/inverseSum, 1e-9))
+			Expect(sumScores[i]).To(BeNumerically("~", sum, 1e-9))
+			Expect(harmonicScores[i] <= geometricScores[i]).To(BeTrue())
+			Expect(geometricScores[i] <= arithmeticScores[i]).To(BeTrue())
+
+			score, err := handlers.NewArithmeticMeanScoringHandler().ScoreToken(collection, token)
+			Expect(err).To(BeNil())
+			Expect(score).To(Equal(arithmeticScores[i]))
+		}
+		Expect(sumScores[0] > sumScores[1]).To(BeTrue())
+		Expect(sumScores[4] > sumScores[2]).To(BeTrue())
+	})
+
+	It("should able to pass test_trait_normalized_rarity", func() {
+		collection, err := GenerateCollectionWithTokenTraits(
+			[]map[string]interface{}{
+				{"bottom": "1", "hat": "1", "serial": "1"},
+				{"bottom": "1", "hat": "1", "serial": "2"},
+				{"bottom": "2", "hat": "2", "serial": "3"},
+				{"bottom": "2", "hat": "3", "serial": "4"},
+			},
+			models.IdentifierTypeEVMContract,
+		)
+		Expect(err).To(BeNil())
+
+		scores, err := openrarity.NewTraitNormalizedScorer().ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		for i, token := range collection.Tokens() {
+			attrScores, attrWeights := scoring.GetTokenAttributesScoresAndWeights(collection, token, true, nil)
+			var expectedScore float64
+			for idx, score := range attrScores {
+				expectedScore += score * attrWeights[idx]
+			}
+			Expect(scores[i]).To(BeNumerically("~", expectedScore, 1e-9))
+		}
+		// the unique serial weighs as much as any other trait value after normalization
+		Expect(scores[3] > scores[0]).To(BeTrue())
+		Expect(scores[2]).To(Equal(scores[3]))
+	})
+
 	_ = oneRareCollection
 })
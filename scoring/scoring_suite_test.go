@@ -13,6 +13,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// testContractAddress is the contract address of the generated EVM tokens
+const testContractAddress = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
 // UniformRarityTokens returns a slice of IToken instances with uniform rarity.
 // The number of attributes and the number of values per attribute are specified
 // by the input arguments attributeCount and valuesPerAttribute, respectively.
@@ -33,7 +36,7 @@ func UniformRarityTokens(
 			)
 		}
 		tokens = append(tokens, models.NewToken(
-			models.NewEVMContractTokenIdentifier("0x0", tokenId),
+			must(models.NewEVMContractTokenIdentifier(testContractAddress, tokenId)),
 			models.TokenStandardERC721,
 			models.NewTokenMetadataFromStringAttributes(stringAttributeMap),
 		))
@@ -63,7 +66,7 @@ func OneRareRarityTokens(
 			)
 		}
 		tokens = append(tokens, models.NewToken(
-			models.NewEVMContractTokenIdentifier("0x0", tokenId),
+			must(models.NewEVMContractTokenIdentifier(testContractAddress, tokenId)),
 			models.TokenStandardERC721,
 			models.NewTokenMetadataFromStringAttributes(stringAttributeMap),
 		))
@@ -77,7 +80,7 @@ func OneRareRarityTokens(
 		)
 	}
 	tokens = append(tokens, models.NewToken(
-		models.NewEVMContractTokenIdentifier("0x0", tokenTotalSupply-1),
+		must(models.NewEVMContractTokenIdentifier(testContractAddress, tokenTotalSupply-1)),
 		models.TokenStandardERC721,
 		models.NewTokenMetadataFromStringAttributes(rareTokenStringAttributeDict),
 	))
@@ -154,9 +157,9 @@ func CreateEVMToken(
 		metadata = must(models.NewTokenMetadataFromAttributes(map[string]interface{}{}))
 	}
 	return openrarity.NewToken(
-		models.NewEVMContractTokenIdentifier(
+		must(models.NewEVMContractTokenIdentifier(
 			contractAddress, tokenId,
-		),
+		)),
 		tokenStandard,
 		metadata,
 	)
@@ -166,6 +169,7 @@ func CreateEVMToken(
 func GenerateCollectionWithTokenTraits(
 	tokensTraits []map[string]interface{},
 	tokenIdentifierType models.IdentifierType,
+	opts ...models.CollectionOption,
 ) (models.ICollection, error) {
 	tokens := make([]models.IToken, 0, len(tokensTraits))
 	for idx, tokenTraits := range tokensTraits {
@@ -175,9 +179,9 @@ func GenerateCollectionWithTokenTraits(
 		)
 		switch tokenIdentifierType {
 		case models.IdentifierTypeEVMContract:
-			identifierType = models.NewEVMContractTokenIdentifier(
-				"0x0", idx,
-			)
+			identifierType = must(models.NewEVMContractTokenIdentifier(
+				testContractAddress, idx,
+			))
 			tokenStandard = models.TokenStandardERC721
 		case models.IdentifierTypeSolanaMintAddress:
 			identifierType = models.NewSolanaMintAddressTokenIdentifier(
@@ -197,7 +201,7 @@ func GenerateCollectionWithTokenTraits(
 			tokenMetadata,
 		))
 	}
-	return models.NewCollection("My Collection", tokens), nil
+	return models.NewCollection("My Collection", tokens, opts...)
 }
 
 func must[V any](value V, err error) V {
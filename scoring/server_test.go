@@ -0,0 +1,196 @@
+package scoring_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing/iotest"
+
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/server"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func ranksOfRecords(records []*oio.RankedToken) []int {
+	ranks := make([]int, 0, len(records))
+	for _, record := range records {
+		ranks = append(ranks, record.Rank)
+	}
+	return ranks
+}
+
+var _ = Describe("Server", func() {
+	contractAddress := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	document := "token_id,background,hat\n" +
+		"1,red,cap\n" +
+		"2,red,\n" +
+		"3,blue,crown\n" +
+		"4,red,cap\n"
+
+	do := func(handler http.Handler, method, target, contentType, body string, v interface{}) int {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if v != nil {
+			Expect(json.Unmarshal(recorder.Body.Bytes(), v)).To(BeNil())
+		}
+		return recorder.Code
+	}
+
+	It("should upload collections and serve their ranks", func() {
+		s := must(server.NewServer())
+		var summary server.CollectionSummary
+		Expect(do(s, http.MethodPut, "/collections/drop?mode=dense_rank&contract_address="+contractAddress,
+			"text/csv; charset=utf-8", document, &summary)).To(Equal(http.StatusCreated))
+		Expect(summary.Name).To(Equal("drop"))
+		Expect(summary.Tokens).To(Equal(4))
+		Expect(summary.Handler).To(Equal(server.DefaultHandler))
+
+		var summaries []*server.CollectionSummary
+		Expect(do(s, http.MethodGet, "/collections", "", "", &summaries)).To(Equal(http.StatusOK))
+		Expect(len(summaries)).To(Equal(1))
+		Expect(string(summaries[0].Mode)).To(Equal("dense_rank"))
+
+		var page server.RanksPage
+		Expect(do(s, http.MethodGet, "/collections/drop/ranks", "", "", &page)).To(Equal(http.StatusOK))
+		Expect(page.Total).To(Equal(4))
+		Expect(ranksOfRecords(page.Ranks)).To(Equal([]int{1, 2, 3, 3}))
+		Expect(string(page.Ranks[0].TokenID)).To(Equal("3"))
+
+		Expect(do(s, http.MethodGet, "/collections/drop/ranks?sort=-rank&offset=1&limit=2", "", "", &page)).To(
+			Equal(http.StatusOK),
+		)
+		Expect(page.Total).To(Equal(4))
+		Expect(ranksOfRecords(page.Ranks)).To(Equal([]int{3, 2}))
+
+		Expect(do(s, http.MethodGet, "/collections/drop/ranks?sort=token_identifier&offset=3", "", "", &page)).To(
+			Equal(http.StatusOK),
+		)
+		Expect(len(page.Ranks)).To(Equal(1))
+		Expect(string(page.Ranks[0].TokenID)).To(Equal("4"))
+
+		var response server.ErrorResponse
+		Expect(do(s, http.MethodGet, "/collections/drop/ranks?limit=0", "", "", &response)).To(
+			Equal(http.StatusBadRequest),
+		)
+		Expect(do(s, http.MethodGet, "/collections/drop/ranks?sort=name", "", "", &response)).To(
+			Equal(http.StatusBadRequest),
+		)
+		Expect(do(s, http.MethodGet, "/collections/other/ranks", "", "", &response)).To(Equal(http.StatusNotFound))
+		Expect(do(s, http.MethodPost, "/collections/drop", "", "", &response)).To(
+			Equal(http.StatusMethodNotAllowed),
+		)
+	})
+
+	It("should serve the rank and the explanation of a token", func() {
+		s := must(server.NewServer())
+		Expect(do(s, http.MethodPut, "/collections/drop?contract_address="+contractAddress,
+			"text/csv", document, nil)).To(Equal(http.StatusCreated))
+
+		var tokenRank server.TokenRank
+		Expect(do(s, http.MethodGet, "/collections/drop/tokens/2", "", "", &tokenRank)).To(Equal(http.StatusOK))
+		Expect(tokenRank.Rank.Rank).To(Equal(2))
+		Expect(tokenRank.Explanation).NotTo(BeNil())
+		Expect(tokenRank.Explanation.Score).To(Equal(tokenRank.Rank.Score))
+		Expect(len(tokenRank.Explanation.Attributes)).To(Equal(3))
+		Expect(tokenRank.Explanation.Attributes[1].IsNull).To(BeTrue())
+
+		Expect(do(s, http.MethodGet, "/collections/drop/tokens/evm_contract:"+contractAddress+":3",
+			"", "", &tokenRank)).To(Equal(http.StatusOK))
+		Expect(tokenRank.Rank.Rank).To(Equal(1))
+
+		var response server.ErrorResponse
+		Expect(do(s, http.MethodGet, "/collections/drop/tokens/9", "", "", &response)).To(
+			Equal(http.StatusNotFound),
+		)
+	})
+
+	It("should reject token IDs matching several tokens", func() {
+		s := must(server.NewServer())
+		otherContractAddress := "0x1000000000000000000000000000000000000001"
+		tokens := `{"token_identifier":"evm_contract:` + contractAddress + `:1","hat":"cap"}` + "\n" +
+			`{"token_identifier":"evm_contract:` + otherContractAddress + `:1","hat":"crown"}` + "\n" +
+			`{"token_identifier":"evm_contract:` + otherContractAddress + `:2","hat":"cap"}` + "\n"
+		Expect(do(s, http.MethodPut, "/collections/drop", "application/jsonl", tokens, nil)).To(
+			Equal(http.StatusCreated),
+		)
+
+		var response server.ErrorResponse
+		Expect(do(s, http.MethodGet, "/collections/drop/tokens/1", "", "", &response)).To(
+			Equal(http.StatusConflict),
+		)
+		Expect(response.Error).To(ContainSubstring(server.ErrAmbiguousToken.Error()))
+
+		var tokenRank server.TokenRank
+		Expect(do(s, http.MethodGet, "/collections/drop/tokens/"+otherContractAddress+":1",
+			"", "", &tokenRank)).To(Equal(http.StatusOK))
+		Expect(tokenRank.Rank.TokenIdentifier.String()).To(Equal("evm_contract:" + otherContractAddress + ":1"))
+		Expect(do(s, http.MethodGet, "/collections/drop/tokens/2", "", "", &tokenRank)).To(Equal(http.StatusOK))
+		Expect(tokenRank.Rank.TokenIdentifier.String()).To(Equal("evm_contract:" + otherContractAddress + ":2"))
+	})
+
+	It("should reject invalid uploads", func() {
+		s := must(server.NewServer(server.WithMaxUploadSize(1 << 10)))
+		var response server.ErrorResponse
+		Expect(do(s, http.MethodPut, "/collections/drop", "application/json", "[]", &response)).To(
+			Equal(http.StatusUnsupportedMediaType),
+		)
+		Expect(do(s, http.MethodPut, "/collections/drop?handler=nope&contract_address="+contractAddress,
+			"text/csv", document, &response)).To(Equal(http.StatusBadRequest))
+		Expect(do(s, http.MethodPut, "/collections/drop", "text/csv", document, &response)).To(
+			Equal(http.StatusBadRequest),
+		)
+		Expect(do(s, http.MethodPut, "/collections/drop", "application/jsonl",
+			`{"token_identifier":"evm_contract:`+contractAddress+`:1","level":3}`, &response)).To(
+			Equal(http.StatusUnprocessableEntity),
+		)
+		Expect(len(response.Violations)).To(Equal(1))
+		Expect(response.Violations[0].Rule).To(Equal(scoring.RuleNumericAttributes))
+		Expect(do(s, http.MethodPut, "/collections/drop?contract_address="+contractAddress, "text/csv",
+			document+"3,red,cap\n", &response)).To(Equal(http.StatusBadRequest))
+		Expect(response.Error).To(ContainSubstring("line 6"))
+		Expect(response.Error).To(ContainSubstring(oio.ErrDuplicateToken.Error()))
+		Expect(do(s, http.MethodPut, "/collections/drop", "text/csv", strings.Repeat("a", 2<<10), &response)).To(
+			Equal(http.StatusRequestEntityTooLarge),
+		)
+
+		request := httptest.NewRequest(http.MethodPut, "/collections/drop",
+			iotest.ErrReader(errors.New("connection reset")))
+		request.Header.Set("Content-Type", "text/csv")
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+
+		var summaries []*server.CollectionSummary
+		Expect(do(s, http.MethodGet, "/collections", "", "", &summaries)).To(Equal(http.StatusOK))
+		Expect(summaries).To(BeEmpty())
+	})
+
+	It("should restore collections from the snapshot file", func() {
+		snapshotFile := filepath.Join(GinkgoT().TempDir(), "snapshot.json")
+		s := must(server.NewServer(server.WithSnapshotFile(snapshotFile)))
+		Expect(do(s, http.MethodPut, "/collections/drop?contract_address="+contractAddress,
+			"text/csv", document, nil)).To(Equal(http.StatusCreated))
+		Expect(do(s, http.MethodPut, "/collections/other?contract_address="+contractAddress,
+			"text/csv", document, nil)).To(Equal(http.StatusCreated))
+		Expect(do(s, http.MethodDelete, "/collections/other", "", "", nil)).To(Equal(http.StatusNoContent))
+
+		restored := must(server.NewServer(server.WithSnapshotFile(snapshotFile)))
+		var summaries []*server.CollectionSummary
+		Expect(do(restored, http.MethodGet, "/collections", "", "", &summaries)).To(Equal(http.StatusOK))
+		Expect(len(summaries)).To(Equal(1))
+		Expect(summaries[0].Name).To(Equal("drop"))
+
+		var page server.RanksPage
+		Expect(do(restored, http.MethodGet, "/collections/drop/ranks", "", "", &page)).To(Equal(http.StatusOK))
+		Expect(ranksOfRecords(page.Ranks)).To(Equal([]int{1, 2, 3, 3}))
+	})
+})
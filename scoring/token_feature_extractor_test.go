@@ -1,6 +1,7 @@
 package scoring_test
 
 import (
+	"github.com/Base-Labs/openrarity"
 	"github.com/Base-Labs/openrarity/models"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,4 +29,44 @@ var _ = Describe("Feature Extractor", func() {
 			).UniqueAttributeCount()).To(Equal(expectedCount[i]))
 		}
 	})
+
+	It("should pass test_registered_feature_extractors", func() {
+		collection, err := GenerateCollectionWithTokenTraits(
+			[]map[string]interface{}{
+				{"bottom": "1", "hat": "1", "special": "1/1"},
+				{"bottom": "1", "hat": "1"},
+				{"bottom": "2", "hat": "2"},
+				{"bottom": "2", "hat": "2"},
+			},
+			models.IdentifierTypeEVMContract,
+		)
+		Expect(err).To(BeNil())
+
+		features := models.ExtractTokenRankingFeatures(
+			collection.Tokens()[0], collection, models.FeatureExtractors(),
+		)
+		Expect(features.UniqueAttributeCount()).To(Equal(2))
+		Expect(features.Features()).To(Equal(map[string]float64{
+			models.UniqueAttributeCountFeatureName:    2,
+			"rare_attribute_count_5":                  4,
+			models.MinAttributeProbabilityFeatureName: 0.25,
+			models.TraitCountFeatureName:              3,
+			models.OneOfOneFeatureName:                1,
+		}))
+		features.Features()[models.OneOfOneFeatureName] = 0
+		oneOfOne, _ := features.Feature(models.OneOfOneFeatureName)
+		Expect(oneOfOne).To(Equal(1.0))
+
+		tokenRarities, err := openrarity.NewRarityRanker(
+			openrarity.WithSortKeys(
+				openrarity.SortByFeature(models.TraitCountFeatureName, false),
+				openrarity.SortByScore(true),
+			),
+		).RankCollection(collection, openrarity.NewOpenRarityScorer())
+		Expect(err).To(BeNil())
+		Expect(tokenRarities[3].Token()).To(Equal(collection.Tokens()[0]))
+		value, exists := tokenRarities[3].TokenFeatures().Feature(models.OneOfOneFeatureName)
+		Expect(exists).To(BeTrue())
+		Expect(value).To(Equal(1.0))
+	})
 })
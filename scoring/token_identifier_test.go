@@ -0,0 +1,203 @@
+package scoring_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/Base-Labs/openrarity/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("Token Identifier", func() {
+	contractAddress := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	evmIdentifier := must(models.NewEVMContractTokenIdentifier(contractAddress, 123))
+	solanaIdentifier := models.NewSolanaMintAddressTokenIdentifier("Fake-Address")
+
+	It("should expose the fields of identifiers", func() {
+		Expect(evmIdentifier.ContractAddress()).To(Equal(contractAddress))
+		Expect(evmIdentifier.TokenID().Int64()).To(Equal(int64(123)))
+		Expect(solanaIdentifier.MintAddress()).To(Equal("Fake-Address"))
+	})
+
+	It("should format and parse the canonical form", func() {
+		Expect(evmIdentifier.String()).To(Equal("evm_contract:" + contractAddress + ":123"))
+		Expect(solanaIdentifier.String()).To(Equal("solana_mint_address:Fake-Address"))
+		for _, identifier := range []models.ITokenIdentifier{evmIdentifier, solanaIdentifier} {
+			parsed, err := models.ParseTokenIdentifier(identifier.String())
+			Expect(err).To(BeNil())
+			Expect(parsed.Equal(identifier)).To(BeTrue())
+		}
+		for _, value := range []string{"evm_contract", "evm_contract:" + contractAddress, "evm_contract:" + contractAddress + ":a", "unknown:1", "solana_mint_address:"} {
+			_, err := models.ParseTokenIdentifier(value)
+			Expect(errors.Is(err, models.ErrInvalidTokenIdentifier)).To(BeTrue())
+		}
+	})
+
+	It("should order identifiers", func() {
+		Expect(evmIdentifier.Compare(must(models.NewEVMContractTokenIdentifier(contractAddress, 124)))).To(Equal(-1))
+		Expect(evmIdentifier.Compare(must(models.NewEVMContractTokenIdentifier("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaec", 124)))).To(Equal(1))
+		Expect(evmIdentifier.Compare(&evmIdentifier)).To(Equal(0))
+		Expect(evmIdentifier.Compare(solanaIdentifier)).To(Equal(-1))
+		Expect(solanaIdentifier.Compare(evmIdentifier)).To(Equal(1))
+		Expect(evmIdentifier.Equal(solanaIdentifier)).To(BeFalse())
+	})
+
+	It("should encode identifiers to JSON", func() {
+		data, err := json.Marshal(evmIdentifier)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"identifier_type":"evm_contract","contract_address":"` + contractAddress + `","token_id":123}`))
+
+		var decoded models.EVMContractTokenIdentifier
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(evmIdentifier))
+
+		data, err = json.Marshal(solanaIdentifier)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"identifier_type":"solana_mint_address","mint_address":"Fake-Address"}`))
+		identifier, err := models.UnmarshalTokenIdentifierJSON(data)
+		Expect(err).To(BeNil())
+		Expect(identifier).To(Equal(solanaIdentifier))
+
+		Expect(json.Unmarshal(data, &decoded)).NotTo(Succeed())
+		_, err = models.UnmarshalTokenIdentifierJSON([]byte(`{"identifier_type":"solana_mint_address"}`))
+		Expect(err).To(MatchError(models.ErrInvalidTokenIdentifier))
+	})
+
+	It("should support uint256 token IDs", func() {
+		hashedID := "0x" + strings.Repeat("f", 64)
+		identifier, err := models.ParseEVMContractTokenIdentifier(contractAddress, hashedID)
+		Expect(err).To(BeNil())
+		maxUint256, _ := new(big.Int).SetString(strings.Repeat("f", 64), 16)
+		Expect(identifier.TokenID()).To(Equal(maxUint256))
+		Expect(identifier.String()).To(Equal("evm_contract:" + contractAddress + ":" + maxUint256.String()))
+
+		parsed, err := models.ParseTokenIdentifier(identifier.String())
+		Expect(err).To(BeNil())
+		Expect(parsed.Equal(identifier)).To(BeTrue())
+		Expect(identifier.Compare(evmIdentifier)).To(Equal(1))
+
+		data, err := json.Marshal(identifier)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"token_id":` + maxUint256.String() + "}"))
+		var decoded models.EVMContractTokenIdentifier
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded.Equal(identifier)).To(BeTrue())
+		Expect(json.Unmarshal(
+			[]byte(`{"identifier_type":"evm_contract","contract_address":"`+contractAddress+`","token_id":"0x7b"}`),
+			&decoded,
+		)).To(Succeed())
+		Expect(decoded.Equal(evmIdentifier)).To(BeTrue())
+
+		for _, value := range []string{"-1", "0x1" + strings.Repeat("0", 64), "abc", ""} {
+			_, err = models.ParseEVMContractTokenIdentifier(contractAddress, value)
+			Expect(errors.Is(err, models.ErrInvalidTokenID)).To(BeTrue())
+		}
+		_, err = models.NewEVMContractTokenIdentifierFromBigInt(contractAddress, big.NewInt(-1))
+		Expect(errors.Is(err, models.ErrInvalidTokenID)).To(BeTrue())
+
+		token, err := models.NewERC721TokenFromIdentifier(identifier, map[string]interface{}{"hat": "cap"})
+		Expect(err).To(BeNil())
+		Expect(token.TokenIdentifier().Equal(identifier)).To(BeTrue())
+	})
+
+	It("should validate and normalize EVM addresses", func() {
+		for _, checksummed := range []string{
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+			"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+			"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		} {
+			normalized, err := models.NormalizeEVMAddress(checksummed)
+			Expect(err).To(BeNil())
+			Expect(normalized).To(Equal(strings.ToLower(checksummed)))
+			Expect(models.ChecksumEVMAddress(normalized)).To(Equal(checksummed))
+			_, err = models.NormalizeEVMAddress(strings.ToUpper(checksummed[:2]) + strings.ToUpper(checksummed[2:]))
+			Expect(err).To(BeNil())
+		}
+		for _, address := range []string{
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+			"0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea",
+			"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed00",
+			"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beazz",
+		} {
+			_, err := models.NormalizeEVMAddress(address)
+			Expect(errors.Is(err, models.ErrInvalidEVMAddress)).To(BeTrue())
+		}
+
+		lower, err := models.ParseEVMContractTokenIdentifier("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "1")
+		Expect(err).To(BeNil())
+		upper, err := models.ParseEVMContractTokenIdentifier(contractAddress, "1")
+		Expect(err).To(BeNil())
+		Expect(lower.Equal(upper)).To(BeTrue())
+		Expect(lower.ChecksumContractAddress()).To(Equal("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+
+		_, err = models.NewERC721Token("0xa3049...", 1, map[string]interface{}{})
+		Expect(errors.Is(err, models.ErrInvalidEVMAddress)).To(BeTrue())
+		_, err = models.NewERC1155Token("0x0", 1, map[string]interface{}{})
+		Expect(errors.Is(err, models.ErrInvalidEVMAddress)).To(BeTrue())
+		_, err = models.NewEVMContractTokenIdentifier("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", 1)
+		Expect(errors.Is(err, models.ErrInvalidEVMAddress)).To(BeTrue())
+		_, err = models.NewEVMContractTokenIdentifier(contractAddress, -1)
+		Expect(errors.Is(err, models.ErrInvalidTokenID)).To(BeTrue())
+		identifier := must(models.NewEVMContractTokenIdentifier("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", 1))
+		Expect(identifier.Equal(upper)).To(BeTrue())
+	})
+
+	It("should support identifiers of other chains", func() {
+		tezosIdentifier, err := models.ParseTezosFA2TokenIdentifier("KT1RJ6PbjHpwc3M5rw5s2Nbmefwbuwbdxton", "152")
+		Expect(err).To(BeNil())
+		ordinalsIdentifier, err := models.NewOrdinalsInscriptionTokenIdentifier(
+			"6FB976AB49DCEC017F1E201E84395983204AE1A7C2ABF7CED0A85D692E442799i0",
+		)
+		Expect(err).To(BeNil())
+		Expect(ordinalsIdentifier.TxID()).To(Equal("6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799"))
+		aptosIdentifier, err := models.NewAptosObjectTokenIdentifier("0xA")
+		Expect(err).To(BeNil())
+		Expect(aptosIdentifier.ObjectID()).To(Equal("0x" + strings.Repeat("0", 63) + "a"))
+		suiIdentifier, err := models.NewSuiObjectTokenIdentifier("0x" + strings.Repeat("0", 63) + "a")
+		Expect(err).To(BeNil())
+		Expect(suiIdentifier.Equal(aptosIdentifier)).To(BeFalse())
+		cosmosIdentifier, err := models.NewCosmosCW721TokenIdentifier(
+			"ABCDEF1QPZRY9X8GF2TVDW0S3JN54KHCE6MUA7LMQQQXW", "token:1",
+		)
+		Expect(err).To(BeNil())
+		Expect(cosmosIdentifier.ContractAddress()).To(Equal("abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw"))
+
+		for _, identifier := range []models.ITokenIdentifier{
+			tezosIdentifier, ordinalsIdentifier, aptosIdentifier, suiIdentifier, cosmosIdentifier,
+		} {
+			parsed, err := models.ParseTokenIdentifier(identifier.String())
+			Expect(err).To(BeNil())
+			Expect(parsed.Equal(identifier)).To(BeTrue())
+
+			data, err := json.Marshal(identifier)
+			Expect(err).To(BeNil())
+			decoded, err := models.UnmarshalTokenIdentifierJSON(data)
+			Expect(err).To(BeNil())
+			Expect(decoded).To(Equal(identifier))
+		}
+
+		ninth, _ := models.NewCosmosCW721TokenIdentifier(cosmosIdentifier.ContractAddress(), "9")
+		tenth, _ := models.NewCosmosCW721TokenIdentifier(cosmosIdentifier.ContractAddress(), "10")
+		Expect(ninth.Compare(tenth)).To(Equal(-1))
+		Expect(tenth.Compare(cosmosIdentifier)).To(Equal(-1))
+
+		for _, value := range []string{
+			"tezos_fa2:tz1RJ6PbjHpwc3M5rw5s2Nbmefwbuwbdxton:1",
+			"tezos_fa2:KT1RJ6PbjHpwc3M5rw5s2Nbmefwbuwbdxton:-1",
+			"ordinals_inscription:6fb976ab49dcec017f1e201e84395983204ae1a7c2abf7ced0a85d692e442799",
+			"aptos_object:0x" + strings.Repeat("0", 65),
+			"sui_object:0xzz",
+			"cosmos_cw721:abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxx:1",
+			"cosmos_cw721:abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw:",
+		} {
+			_, err := models.ParseTokenIdentifier(value)
+			Expect(errors.Is(err, models.ErrInvalidTokenIdentifier)).To(BeTrue(), value)
+		}
+		_, err = models.NormalizeBech32Address("abcdef1Qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw")
+		Expect(errors.Is(err, models.ErrInvalidBech32Address)).To(BeTrue())
+	})
+})
@@ -15,6 +15,34 @@ func GetTokenAttributesScoresAndWeights(
 	normalized bool,
 	collectionNullAttributes map[models.AttributeName]*models.CollectionAttribute,
 ) ([]float64, []float64) {
+	sortedAttrs := GetTokenCollectionAttributes(collection, token, collectionNullAttributes)
+	totalSupply := collection.TokenTotalSupply()
+
+	attrWeights := make([]float64, 0, len(sortedAttrs))
+	if normalized {
+		for _, attr := range sortedAttrs {
+			attrWeights = append(attrWeights, float64(1)/float64(collection.TotalAttributeValues(attr.Attribute.Name())))
+		}
+	} else {
+		for range sortedAttrs {
+			attrWeights = append(attrWeights, float64(len(sortedAttrs)))
+		}
+	}
+	scores := make([]float64, 0, len(sortedAttrs))
+	for _, attr := range sortedAttrs {
+		scores = append(scores, float64(totalSupply)/float64(attr.TotalTokens))
+	}
+	return scores, attrWeights
+}
+
+// GetTokenCollectionAttributes is used to get the collection attribute of every attribute of the token,
+// sorted by attribute name. If the token does not have an attribute, the null attribute
+// of the collection is used instead.
+func GetTokenCollectionAttributes(
+	collection models.ICollection,
+	token models.IToken,
+	collectionNullAttributes map[models.AttributeName]*models.CollectionAttribute,
+) []*models.CollectionAttribute {
 	nullAttributes := collectionNullAttributes
 	if nullAttributes == nil {
 		nullAttributes = collection.ExtractNullAttributes()
@@ -28,23 +56,7 @@ func GetTokenAttributesScoresAndWeights(
 	for _, name := range sortedAttrNames {
 		sortedAttrs = append(sortedAttrs, combinedAttributes[name])
 	}
-	totalSupply := collection.TokenTotalSupply()
-
-	attrWeights := make([]float64, 0, len(sortedAttrNames))
-	if normalized {
-		for _, attrName := range sortedAttrNames {
-			attrWeights = append(attrWeights, float64(1)/float64(collection.TotalAttributeValues(attrName)))
-		}
-	} else {
-		for range sortedAttrNames {
-			attrWeights = append(attrWeights, float64(len(sortedAttrNames)))
-		}
-	}
-	scores := make([]float64, 0, len(sortedAttrs))
-	for _, attr := range sortedAttrs {
-		scores = append(scores, float64(totalSupply)/float64(attr.TotalTokens))
-	}
-	return scores, attrWeights
+	return sortedAttrs
 }
 
 // GetMapKeys is used to all keys in a map
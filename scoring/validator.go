@@ -0,0 +1,282 @@
+package scoring
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/pkg/errors"
+)
+
+// IValidator is used to check whether a collection is eligible for scoring.
+// Validators are chained on the Scorer, and every violation of every validator
+// is collected into a ValidationReport.
+type IValidator interface {
+	// Validate is used to check the collection and return every violation found
+	Validate(collection models.ICollection) []*Violation
+}
+
+// ValidatorFunc is an adapter to allow the use of ordinary functions as validators
+type ValidatorFunc func(collection models.ICollection) []*Violation
+
+var _ IValidator = ValidatorFunc(nil)
+
+// Validate is used to check the collection and return every violation found
+func (f ValidatorFunc) Validate(collection models.ICollection) []*Violation {
+	return f(collection)
+}
+
+// defines the rule names of the built-in validators
+const (
+	RuleTokenStandards     = "token_standards"
+	RuleNumericAttributes  = "numeric_attributes"
+	RuleMinCollectionSize  = "min_collection_size"
+	RuleMaxDistinctValues  = "max_distinct_attribute_values"
+	RuleMixedIdentifiers   = "mixed_identifier_types"
+	RuleEmptyTokenMetadata = "empty_token_metadata"
+)
+
+// Violation describes a rule broken by a collection, or by one of its tokens
+type Violation struct {
+	// Rule is the name of the broken rule
+	Rule string
+	// Message describes why the rule is broken
+	Message string
+	// Token is the token breaking the rule, nil if the rule applies to the whole collection
+	Token models.IToken
+	// Err is the error matching the broken rule, if any, it can be checked with errors.Is
+	// or errors.As on the ValidationReport.
+	Err error
+}
+
+// ValidationReport holds every violation found when validating a collection
+type ValidationReport struct {
+	Violations []*Violation
+}
+
+// Error is used to join the messages of all violations
+func (r *ValidationReport) Error() string {
+	messages := make([]string, 0, len(r.Violations))
+	for _, violation := range r.Violations {
+		messages = append(messages, violation.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is is used to report whether any violation matches target, so that errors.Is works
+// on the report.
+func (r *ValidationReport) Is(target error) bool {
+	for _, violation := range r.Violations {
+		if violation.Err != nil && errors.Is(violation.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As is used to find the first violation error matching target, so that errors.As works
+// on the report.
+func (r *ValidationReport) As(target interface{}) bool {
+	for _, violation := range r.Violations {
+		if violation.Err != nil && errors.As(violation.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultValidators is used to build the validators applied when NewScorer is given none
+func DefaultValidators() []IValidator {
+	return []IValidator{
+		NewNumericAttributesValidator(),
+		NewTokenStandardsValidator(models.NonFungibleTokenStandards()...),
+	}
+}
+
+// TokenStandardsValidator rejects collections holding tokens of other standards than the allowed ones.
+// ERC1155 tokens are also allowed when the collection knows their supplies.
+type TokenStandardsValidator struct {
+	allowedStandards []models.TokenStandard
+}
+
+var _ IValidator = &TokenStandardsValidator{}
+
+// NewTokenStandardsValidator is the constructor of TokenStandardsValidator
+func NewTokenStandardsValidator(allowedStandards ...models.TokenStandard) *TokenStandardsValidator {
+	return &TokenStandardsValidator{
+		allowedStandards: allowedStandards,
+	}
+}
+
+// Validate is used to check the collection and return every violation found
+func (c *TokenStandardsValidator) Validate(collection models.ICollection) []*Violation {
+	allowedStandards := c.allowedStandards
+	// semi-fungible tokens are only supported when the collection knows their supplies
+	if _, ok := collection.(models.IERC1155Collection); ok {
+		allowedStandards = append([]models.TokenStandard{models.TokenStandardERC1155}, allowedStandards...)
+	}
+	if models.IsSubset(allowedStandards, collection.TokenStandards()) {
+		return nil
+	}
+	var unsupportedStandards, supportedStandards []string
+	for _, standard := range collection.TokenStandards() {
+		if !models.IsSubset(allowedStandards, []models.TokenStandard{standard}) {
+			unsupportedStandards = append(unsupportedStandards, string(standard))
+		}
+	}
+	for _, standard := range allowedStandards {
+		supportedStandards = append(supportedStandards, string(standard))
+	}
+	sort.Strings(unsupportedStandards)
+	err := errors.Wrapf(ErrUnsupportedStandard, "collection holds %s tokens, allowed standards are %s",
+		strings.Join(unsupportedStandards, ", "), strings.Join(supportedStandards, ", "))
+	return []*Violation{{
+		Rule:    RuleTokenStandards,
+		Message: err.Error(),
+		Err:     err,
+	}}
+}
+
+// NumericAttributesValidator rejects collections holding numeric or date attributes
+// which have not been bucketed into string attributes.
+type NumericAttributesValidator struct{}
+
+var _ IValidator = &NumericAttributesValidator{}
+
+// NewNumericAttributesValidator is the constructor of NumericAttributesValidator
+func NewNumericAttributesValidator() *NumericAttributesValidator {
+	return &NumericAttributesValidator{}
+}
+
+// Validate is used to check the collection and return every violation found
+func (c *NumericAttributesValidator) Validate(collection models.ICollection) []*Violation {
+	if !collection.HasNumericAttribute() {
+		return nil
+	}
+	return []*Violation{{
+		Rule:    RuleNumericAttributes,
+		Message: ErrNumericTraitsUnsupported.Error(),
+		Err:     ErrNumericTraitsUnsupported,
+	}}
+}
+
+// MinCollectionSizeValidator rejects collections with fewer tokens than the minimum size.
+type MinCollectionSizeValidator struct {
+	minSize int
+}
+
+var _ IValidator = &MinCollectionSizeValidator{}
+
+// NewMinCollectionSizeValidator is the constructor of MinCollectionSizeValidator
+func NewMinCollectionSizeValidator(minSize int) *MinCollectionSizeValidator {
+	return &MinCollectionSizeValidator{
+		minSize: minSize,
+	}
+}
+
+// Validate is used to check the collection and return every violation found
+func (c *MinCollectionSizeValidator) Validate(collection models.ICollection) []*Violation {
+	if size := len(collection.Tokens()); size < c.minSize {
+		return []*Violation{{
+			Rule:    RuleMinCollectionSize,
+			Message: fmt.Sprintf("collection has %d tokens, at least %d are required", size, c.minSize),
+		}}
+	}
+	return nil
+}
+
+// MaxDistinctValuesValidator rejects collections holding an attribute with more distinct
+// values than the maximum, which usually indicates identifiers or free text traits. Meta
+// attributes, such as the trait count, are not checked.
+type MaxDistinctValuesValidator struct {
+	maxValues int
+}
+
+var _ IValidator = &MaxDistinctValuesValidator{}
+
+// NewMaxDistinctValuesValidator is the constructor of MaxDistinctValuesValidator
+func NewMaxDistinctValuesValidator(maxValues int) *MaxDistinctValuesValidator {
+	return &MaxDistinctValuesValidator{
+		maxValues: maxValues,
+	}
+}
+
+// Validate is used to check the collection and return every violation found
+func (c *MaxDistinctValuesValidator) Validate(collection models.ICollection) []*Violation {
+	attrNames := GetMapKeys(collection.ExtractCollectionAttributes())
+	sort.Strings(attrNames)
+	var violations []*Violation
+	for _, attrName := range attrNames {
+		if strings.HasPrefix(attrName, models.MetaTraitAttributePrefix) {
+			continue
+		}
+		if values := collection.TotalAttributeValues(attrName); values > c.maxValues {
+			violations = append(violations, &Violation{
+				Rule: RuleMaxDistinctValues,
+				Message: fmt.Sprintf("attribute %q has %d distinct values, at most %d are allowed",
+					attrName, values, c.maxValues),
+			})
+		}
+	}
+	return violations
+}
+
+// MixedIdentifiersValidator rejects collections whose tokens are identified by different
+// identifier types.
+type MixedIdentifiersValidator struct{}
+
+var _ IValidator = &MixedIdentifiersValidator{}
+
+// NewMixedIdentifiersValidator is the constructor of MixedIdentifiersValidator
+func NewMixedIdentifiersValidator() *MixedIdentifiersValidator {
+	return &MixedIdentifiersValidator{}
+}
+
+// Validate is used to check the collection and return every violation found
+func (c *MixedIdentifiersValidator) Validate(collection models.ICollection) []*Violation {
+	identifierTypes := models.NewSet[models.IdentifierType](1)
+	for _, token := range collection.Tokens() {
+		identifierTypes.Add(token.TokenIdentifier().IdentifierType())
+	}
+	if len(identifierTypes.List()) <= 1 {
+		return nil
+	}
+	names := make([]string, 0, len(identifierTypes.List()))
+	for _, identifierType := range identifierTypes.List() {
+		names = append(names, string(identifierType))
+	}
+	return []*Violation{{
+		Rule:    RuleMixedIdentifiers,
+		Message: "collection mixes token identifier types: " + strings.Join(names, ", "),
+	}}
+}
+
+// EmptyTokenMetadataValidator rejects collections holding tokens without any trait.
+type EmptyTokenMetadataValidator struct{}
+
+var _ IValidator = &EmptyTokenMetadataValidator{}
+
+// NewEmptyTokenMetadataValidator is the constructor of EmptyTokenMetadataValidator
+func NewEmptyTokenMetadataValidator() *EmptyTokenMetadataValidator {
+	return &EmptyTokenMetadataValidator{}
+}
+
+// Validate is used to check the collection and return every violation found
+func (c *EmptyTokenMetadataValidator) Validate(collection models.ICollection) []*Violation {
+	var violations []*Violation
+	for idx, token := range collection.Tokens() {
+		traitCount := token.TraitCount()
+		if token.HasAttribute(models.TraitCountAttributeName) {
+			traitCount--
+		}
+		if traitCount <= 0 {
+			violations = append(violations, &Violation{
+				Rule:    RuleEmptyTokenMetadata,
+				Message: fmt.Sprintf("token at index %d has empty metadata", idx),
+				Token:   token,
+			})
+		}
+	}
+	return violations
+}
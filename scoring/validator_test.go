@@ -0,0 +1,86 @@
+package scoring_test
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("Validator", func() {
+	It("should report every violation", func() {
+		tokens := []models.IToken{
+			CreateEVMToken(0, testContractAddress, models.TokenStandardERC1155, nil),
+			models.NewToken(
+				models.NewSolanaMintAddressTokenIdentifier("Fake-Address"),
+				models.TokenStandardMetaplexNonFungible,
+				must(models.NewTokenMetadataFromAttributes(map[string]interface{}{"hat": "cap", "level": 1})),
+			),
+		}
+		collection := must(models.NewCollection("test", tokens))
+		scorer := scoring.NewScorer(
+			handlers.NewInformationContentScoringHandler(),
+			scoring.WithValidators(append(
+				scoring.DefaultValidators(),
+				scoring.NewMinCollectionSizeValidator(10),
+				scoring.NewMaxDistinctValuesValidator(0),
+				scoring.NewMixedIdentifiersValidator(),
+				scoring.NewEmptyTokenMetadataValidator(),
+			)...),
+		)
+		err := scorer.ValidateCollection(collection)
+		Expect(err).NotTo(BeNil())
+
+		var report *scoring.ValidationReport
+		Expect(errors.As(err, &report)).To(BeTrue())
+		var rules []string
+		for _, violation := range report.Violations {
+			rules = append(rules, violation.Rule)
+		}
+		Expect(rules).To(Equal([]string{
+			scoring.RuleNumericAttributes,
+			scoring.RuleTokenStandards,
+			scoring.RuleMinCollectionSize,
+			scoring.RuleMaxDistinctValues,
+			scoring.RuleMixedIdentifiers,
+			scoring.RuleEmptyTokenMetadata,
+		}))
+		Expect(report.Violations[5].Token).To(Equal(tokens[0]))
+		Expect(report.Violations[3].Message).To(ContainSubstring(`"hat"`))
+		// the trait counts of the tokens differ, but meta attributes are not checked
+		Expect(scoring.NewMaxDistinctValuesValidator(1).Validate(collection)).To(BeEmpty())
+	})
+
+	It("should allow relaxing the validation", func() {
+		tokens := []models.IToken{
+			CreateEVMToken(0, testContractAddress, models.TokenStandardERC1155, nil),
+			CreateEVMToken(1, testContractAddress, models.TokenStandardERC1155, nil),
+		}
+		collection := must(models.NewCollection("test", tokens))
+		scorer := scoring.NewScorer(
+			handlers.NewInformationContentScoringHandler(),
+			scoring.WithValidators(),
+		)
+		Expect(scorer.ValidateCollection(collection)).To(BeNil())
+		scores, err := scorer.ScoreCollection(collection)
+		Expect(err).To(BeNil())
+		Expect(len(scores)).To(Equal(2))
+	})
+
+	It("should accept the non-fungible standards of other chains by default", func() {
+		tokens := make([]models.IToken, 0, 2)
+		for _, tokenID := range []string{"1", "2"} {
+			identifier, err := models.NewCosmosCW721TokenIdentifier("abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", tokenID)
+			Expect(err).To(BeNil())
+			tokens = append(tokens, models.NewToken(
+				identifier,
+				models.TokenStandardCW721,
+				must(models.NewTokenMetadataFromAttributes(map[string]interface{}{"hat": "cap" + tokenID})),
+			))
+		}
+		scorer := scoring.NewScorer(handlers.NewInformationContentScoringHandler())
+		Expect(scorer.ValidateCollection(must(models.NewCollection("test", tokens)))).To(BeNil())
+	})
+})
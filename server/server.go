@@ -0,0 +1,453 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Base-Labs/openrarity"
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/Base-Labs/openrarity/scoring/handlers"
+	"github.com/pkg/errors"
+)
+
+// defines the defaults of the server
+const (
+	// DefaultHandler is the score handler of collections uploaded without one
+	DefaultHandler = handlers.InformationContentHandlerName
+	// DefaultPageSize is the number of ranks returned when the limit parameter is missing
+	DefaultPageSize = 100
+	// MaxPageSize is the greatest number of ranks returned at once
+	MaxPageSize = 1000
+	// DefaultMaxUploadSize is the greatest size of an uploaded collection, in bytes
+	DefaultMaxUploadSize = 64 << 20
+)
+
+// defines the sort orders of ranks, prefixed with "-" for the descending order
+const (
+	SortByRank            = "rank"
+	SortByScore           = "score"
+	SortByTokenIdentifier = "token_identifier"
+)
+
+// defines a set of errors returned by the server
+var (
+	// ErrCollectionNotFound is returned when no collection is stored under the requested name
+	ErrCollectionNotFound = errors.New("collection not found")
+	// ErrTokenNotFound is returned when the requested token is not in the collection
+	ErrTokenNotFound = errors.New("token not found")
+	// ErrAmbiguousToken is returned when the requested token ID matches several tokens of the
+	// collection, which may then be requested by their canonical token identifier
+	ErrAmbiguousToken = errors.New("ambiguous token")
+	// ErrInvalidParameter is returned when a query parameter can't be parsed
+	ErrInvalidParameter = errors.New("invalid parameter")
+)
+
+// Server is an http.Handler serving a JSON API to upload collections, and to query the ranks
+// and the score explanations of their tokens:
+//
+//	GET    /collections                        list the collections
+//	PUT    /collections/{name}                 upload a CSV or JSON Lines collection
+//	GET    /collections/{name}                 describe a collection
+//	DELETE /collections/{name}                 delete a collection
+//	GET    /collections/{name}/ranks           list the ranks, see the offset, limit and sort parameters
+//	GET    /collections/{name}/tokens/{token}  get the rank and the score explanation of a token
+//
+// Uploads accept the handler, mode and contract_address query parameters. Tokens are
+// identified by their canonical token identifier, or by its last field, such as the token ID
+// of EVM tokens, which must then match a single token of the collection.
+type Server struct {
+	store         *Store
+	snapshotFile  string
+	maxUploadSize int64
+}
+
+var _ http.Handler = &Server{}
+
+// Option is used to configure the optional behaviours of Server
+type Option func(c *Server)
+
+// WithSnapshotFile is used to restore the collections from the given file, and to write the
+// uploaded collections to it after every change.
+func WithSnapshotFile(file string) Option {
+	return func(c *Server) {
+		c.snapshotFile = file
+	}
+}
+
+// WithMaxUploadSize is used to limit the size of uploaded collections, in bytes
+func WithMaxUploadSize(size int64) Option {
+	return func(c *Server) {
+		c.maxUploadSize = size
+	}
+}
+
+// NewServer is the constructor of Server, it fails if the snapshot file can't be restored
+func NewServer(opts ...Option) (*Server, error) {
+	c := &Server{
+		maxUploadSize: DefaultMaxUploadSize,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	store, err := NewStore(c.snapshotFile)
+	if err != nil {
+		return nil, err
+	}
+	c.store = store
+	return c, nil
+}
+
+// Store is used to get the store of the scored collections
+func (c *Server) Store() *Store {
+	return c.store
+}
+
+// CollectionSummary describes a stored collection
+type CollectionSummary struct {
+	Name      string                 `json:"name"`
+	Tokens    int                    `json:"tokens"`
+	Handler   string                 `json:"handler"`
+	Mode      openrarity.RankingMode `json:"mode"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// RanksPage is a page of the ranked tokens of a collection
+type RanksPage struct {
+	Collection string             `json:"collection"`
+	Total      int                `json:"total"`
+	Offset     int                `json:"offset"`
+	Limit      int                `json:"limit"`
+	Sort       string             `json:"sort"`
+	Ranks      []*oio.RankedToken `json:"ranks"`
+}
+
+// TokenRank holds the rank of a token and the explanation of its score, which is missing if the
+// score handler is unable to explain scores.
+type TokenRank struct {
+	Collection  string                    `json:"collection"`
+	Rank        *oio.RankedToken          `json:"rank"`
+	Explanation *scoring.TokenExplanation `json:"explanation,omitempty"`
+}
+
+// ErrorResponse is the body of failed requests
+type ErrorResponse struct {
+	Error      string       `json:"error"`
+	Violations []*Violation `json:"violations,omitempty"`
+}
+
+// Violation describes a rule broken by an uploaded collection, see scoring.Violation
+type Violation struct {
+	Rule            string `json:"rule"`
+	Message         string `json:"message"`
+	TokenIdentifier string `json:"token_identifier,omitempty"`
+}
+
+// ServeHTTP is used to route the requests of the JSON API
+func (c *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments, err := splitPath(r.URL.EscapedPath())
+	if err != nil || len(segments) == 0 || segments[0] != "collections" {
+		c.writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+	switch {
+	case len(segments) == 1:
+		c.route(w, r, map[string]http.HandlerFunc{
+			http.MethodGet: c.listCollections,
+		})
+	case len(segments) == 2:
+		name := segments[1]
+		c.route(w, r, map[string]http.HandlerFunc{
+			http.MethodGet:    func(w http.ResponseWriter, r *http.Request) { c.getCollection(w, r, name) },
+			http.MethodPut:    func(w http.ResponseWriter, r *http.Request) { c.putCollection(w, r, name) },
+			http.MethodDelete: func(w http.ResponseWriter, r *http.Request) { c.deleteCollection(w, r, name) },
+		})
+	case len(segments) == 3 && segments[2] == "ranks":
+		name := segments[1]
+		c.route(w, r, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, r *http.Request) { c.getRanks(w, r, name) },
+		})
+	case len(segments) == 4 && segments[2] == "tokens":
+		name, token := segments[1], segments[3]
+		c.route(w, r, map[string]http.HandlerFunc{
+			http.MethodGet: func(w http.ResponseWriter, r *http.Request) { c.getToken(w, r, name, token) },
+		})
+	default:
+		c.writeError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+func (c *Server) route(w http.ResponseWriter, r *http.Request, routes map[string]http.HandlerFunc) {
+	if handler, ok := routes[r.Method]; ok {
+		handler(w, r)
+		return
+	}
+	methods := make([]string, 0, len(routes))
+	for method := range routes {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	c.writeError(w, http.StatusMethodNotAllowed, errors.Errorf("method %s not allowed", r.Method))
+}
+
+func (c *Server) listCollections(w http.ResponseWriter, _ *http.Request) {
+	collections := c.store.List()
+	summaries := make([]*CollectionSummary, 0, len(collections))
+	for _, collection := range collections {
+		summaries = append(summaries, newCollectionSummary(collection))
+	}
+	c.writeJSON(w, http.StatusOK, summaries)
+}
+
+func (c *Server) getCollection(w http.ResponseWriter, _ *http.Request, name string) {
+	collection, ok := c.store.Get(name)
+	if !ok {
+		c.writeError(w, http.StatusNotFound, errors.Wrapf(ErrCollectionNotFound, "%q", name))
+		return
+	}
+	c.writeJSON(w, http.StatusOK, newCollectionSummary(collection))
+}
+
+func (c *Server) putCollection(w http.ResponseWriter, r *http.Request, name string) {
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		contentType = r.Header.Get("Content-Type")
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, c.maxUploadSize))
+	if err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		c.writeError(w, status, err)
+		return
+	}
+	query := r.URL.Query()
+	upload := &Upload{
+		Name:            name,
+		ContentType:     contentType,
+		Data:            data,
+		Handler:         query.Get("handler"),
+		Mode:            openrarity.RankingMode(query.Get("mode")),
+		ContractAddress: query.Get("contract_address"),
+		CreatedAt:       time.Now().UTC(),
+	}
+	collection, err := c.store.Put(upload)
+	if err != nil {
+		c.writeError(w, uploadErrorStatus(err), err)
+		return
+	}
+	c.writeJSON(w, http.StatusCreated, newCollectionSummary(collection))
+}
+
+func (c *Server) deleteCollection(w http.ResponseWriter, _ *http.Request, name string) {
+	deleted, err := c.store.Delete(name)
+	if err != nil {
+		c.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if !deleted {
+		c.writeError(w, http.StatusNotFound, errors.Wrapf(ErrCollectionNotFound, "%q", name))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Server) getRanks(w http.ResponseWriter, r *http.Request, name string) {
+	collection, ok := c.store.Get(name)
+	if !ok {
+		c.writeError(w, http.StatusNotFound, errors.Wrapf(ErrCollectionNotFound, "%q", name))
+		return
+	}
+	query := r.URL.Query()
+	offset, err := intParameter(query, "offset", 0, 0, -1)
+	if err != nil {
+		c.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	limit, err := intParameter(query, "limit", DefaultPageSize, 1, MaxPageSize)
+	if err != nil {
+		c.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	sortOrder := query.Get("sort")
+	if sortOrder == "" {
+		sortOrder = SortByRank
+	}
+	ranks, err := sortRanks(collection.Ranks(), sortOrder)
+	if err != nil {
+		c.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	page := &RanksPage{
+		Collection: name,
+		Total:      len(ranks),
+		Offset:     offset,
+		Limit:      limit,
+		Sort:       sortOrder,
+		Ranks:      []*oio.RankedToken{},
+	}
+	if offset < len(ranks) {
+		end := offset + limit
+		if end > len(ranks) {
+			end = len(ranks)
+		}
+		page.Ranks = ranks[offset:end]
+	}
+	c.writeJSON(w, http.StatusOK, page)
+}
+
+func (c *Server) getToken(w http.ResponseWriter, _ *http.Request, name, token string) {
+	collection, ok := c.store.Get(name)
+	if !ok {
+		c.writeError(w, http.StatusNotFound, errors.Wrapf(ErrCollectionNotFound, "%q", name))
+		return
+	}
+	record, err := collection.FindToken(token)
+	if err != nil {
+		status := http.StatusNotFound
+		if errors.Is(err, ErrAmbiguousToken) {
+			status = http.StatusConflict
+		}
+		c.writeError(w, status, err)
+		return
+	}
+	explanation, err := collection.ExplainToken(record)
+	if err != nil && !errors.Is(err, scoring.ErrExplanationUnsupported) {
+		c.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	c.writeJSON(w, http.StatusOK, &TokenRank{
+		Collection:  name,
+		Rank:        record,
+		Explanation: explanation,
+	})
+}
+
+func (c *Server) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (c *Server) writeError(w http.ResponseWriter, status int, err error) {
+	response := &ErrorResponse{Error: err.Error()}
+	var report *scoring.ValidationReport
+	if errors.As(err, &report) {
+		for _, violation := range report.Violations {
+			v := &Violation{Rule: violation.Rule, Message: violation.Message}
+			if violation.Token != nil {
+				v.TokenIdentifier = violation.Token.TokenIdentifier().String()
+			}
+			response.Violations = append(response.Violations, v)
+		}
+	}
+	c.writeJSON(w, status, response)
+}
+
+func newCollectionSummary(collection *ScoredCollection) *CollectionSummary {
+	upload := collection.Upload()
+	return &CollectionSummary{
+		Name:      upload.Name,
+		Tokens:    len(collection.Ranks()),
+		Handler:   upload.Handler,
+		Mode:      upload.Mode,
+		CreatedAt: upload.CreatedAt,
+	}
+}
+
+// uploadErrorStatus is used to get the status code of a failed upload
+func uploadErrorStatus(err error) int {
+	var report *scoring.ValidationReport
+	switch {
+	case errors.As(err, &report):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, ErrUnsupportedContentType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, ErrInvalidCollection),
+		errors.Is(err, scoring.ErrUnknownScoreHandler),
+		errors.Is(err, openrarity.ErrUnknownRankingMode):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// splitPath is used to split an escaped URL path into unescaped segments, so that segments
+// may hold escaped slashes.
+func splitPath(escapedPath string) ([]string, error) {
+	escapedPath = strings.Trim(escapedPath, "/")
+	if escapedPath == "" {
+		return nil, nil
+	}
+	segments := strings.Split(escapedPath, "/")
+	for idx, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil || unescaped == "" {
+			return nil, errors.Errorf("invalid path segment %q", segment)
+		}
+		segments[idx] = unescaped
+	}
+	return segments, nil
+}
+
+// intParameter is used to read an integer query parameter within [min, max], max is ignored if negative
+func intParameter(query url.Values, name string, defaultValue, min, max int) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil || v < min || (max >= 0 && v > max) {
+		return 0, errors.Wrapf(ErrInvalidParameter, "%s: %q", name, value)
+	}
+	return v, nil
+}
+
+// sortRanks is used to sort the ranked tokens by the given order, ties are broken by rank then
+// by token identifier. The given slice is left untouched.
+func sortRanks(ranks []*oio.RankedToken, order string) ([]*oio.RankedToken, error) {
+	key := strings.TrimPrefix(order, "-")
+	descending := key != order
+	var compare func(a, b *oio.RankedToken) int
+	switch key {
+	case SortByRank:
+		if !descending {
+			return ranks, nil
+		}
+		compare = func(a, b *oio.RankedToken) int { return a.Rank - b.Rank }
+	case SortByScore:
+		compare = func(a, b *oio.RankedToken) int {
+			switch {
+			case a.Score < b.Score:
+				return -1
+			case a.Score > b.Score:
+				return 1
+			default:
+				return 0
+			}
+		}
+	case SortByTokenIdentifier:
+		compare = func(a, b *oio.RankedToken) int { return a.TokenIdentifier.Compare(b.TokenIdentifier) }
+	default:
+		return nil, errors.Wrapf(ErrInvalidParameter, "sort: %q", order)
+	}
+	sorted := append([]*oio.RankedToken(nil), ranks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		cmp := compare(sorted[i], sorted[j])
+		if descending {
+			cmp = -cmp
+		}
+		return cmp < 0
+	})
+	return sorted, nil
+}
@@ -0,0 +1,312 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Base-Labs/openrarity"
+	oio "github.com/Base-Labs/openrarity/io"
+	"github.com/Base-Labs/openrarity/models"
+	"github.com/Base-Labs/openrarity/scoring"
+	"github.com/pkg/errors"
+)
+
+// defines the content types of uploaded collections
+const (
+	ContentTypeCSV    = "text/csv"
+	ContentTypeJSONL  = "application/jsonl"
+	ContentTypeNDJSON = "application/x-ndjson"
+)
+
+// defines a set of errors returned by the store
+var (
+	// ErrInvalidCollection is returned when an uploaded collection can't be read
+	ErrInvalidCollection = errors.New("invalid collection")
+	// ErrUnsupportedContentType is returned when a collection is uploaded in an unknown format
+	ErrUnsupportedContentType = errors.New("unsupported content type, must be text/csv or application/jsonl")
+)
+
+// Upload describes an uploaded collection and how it's scored and ranked. Snapshots hold the
+// uploads, so that collections are scored again when the store is restored.
+type Upload struct {
+	// Name is the name of the collection
+	Name string `json:"name"`
+	// ContentType is the format of Data, either ContentTypeCSV or ContentTypeJSONL
+	ContentType string `json:"content_type"`
+	// Data holds the tokens of the collection, see io.CSVReader and io.JSONLReader
+	Data []byte `json:"data"`
+	// Handler is the name of the score handler, see scoring.NewScoreHandler
+	Handler string `json:"handler"`
+	// Mode is the ranking mode of tied tokens
+	Mode openrarity.RankingMode `json:"mode"`
+	// ContractAddress is the contract address of EVM tokens without one
+	ContractAddress string `json:"contract_address,omitempty"`
+	// CreatedAt is the time of the upload
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ScoredCollection is a collection ranked according to its upload
+type ScoredCollection struct {
+	upload     *Upload
+	collection *models.Collection
+	scorer     scoring.IScorer
+	ranks      []*oio.RankedToken
+	// rankedTokens holds the token of every item of ranks
+	rankedTokens []models.IToken
+	// tokens holds the index of the tokens in ranks by canonical token identifier
+	tokens map[string]int
+	// suffixes holds the indexes of the tokens in ranks by every suffix of their token identifier
+	// following a ":", such as their token ID
+	suffixes map[string][]int
+}
+
+// NewScoredCollection is used to read the collection of an upload, then score and rank its tokens.
+// It returns ErrInvalidCollection if the collection can't be read, or holds several tokens with
+// the same token identifier.
+func NewScoredCollection(upload *Upload) (*ScoredCollection, error) {
+	if upload.Handler == "" {
+		upload.Handler = DefaultHandler
+	}
+	if upload.Mode == "" {
+		upload.Mode = openrarity.RankingModeRank
+	}
+	scorer, err := openrarity.NewScorerByName(upload.Handler)
+	if err != nil {
+		return nil, err
+	}
+	opts := []oio.Option{oio.WithContractAddress(upload.ContractAddress)}
+	var collection *models.Collection
+	switch upload.ContentType {
+	case ContentTypeCSV:
+		collection, err = oio.ReadCSVCollection(bytes.NewReader(upload.Data), upload.Name, opts...)
+	case ContentTypeJSONL, ContentTypeNDJSON:
+		collection, err = oio.ReadJSONLCollection(bytes.NewReader(upload.Data), upload.Name, opts...)
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedContentType, "%q", upload.ContentType)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidCollection, "%v", err)
+	}
+	identifiers := make(map[string]struct{}, len(collection.Tokens()))
+	for _, token := range collection.Tokens() {
+		identifier := token.TokenIdentifier().String()
+		if _, exists := identifiers[identifier]; exists {
+			return nil, errors.Wrapf(ErrInvalidCollection, "duplicate token %s", identifier)
+		}
+		identifiers[identifier] = struct{}{}
+	}
+	ranker := openrarity.NewRarityRanker(openrarity.WithRankingMode(upload.Mode))
+	tokenRarities, err := ranker.RankCollection(collection, scorer)
+	if err != nil {
+		return nil, err
+	}
+	c := &ScoredCollection{
+		upload:       upload,
+		collection:   collection,
+		scorer:       scorer,
+		ranks:        oio.NewRankedTokens(tokenRarities),
+		rankedTokens: make([]models.IToken, 0, len(tokenRarities)),
+		tokens:       make(map[string]int, len(tokenRarities)),
+		suffixes:     make(map[string][]int, len(tokenRarities)),
+	}
+	for _, tokenRarity := range tokenRarities {
+		c.rankedTokens = append(c.rankedTokens, tokenRarity.Token())
+	}
+	for idx, record := range c.ranks {
+		identifier := record.TokenIdentifier.String()
+		c.tokens[identifier] = idx
+		for i := range identifier {
+			if identifier[i] == ':' {
+				suffix := identifier[i+1:]
+				c.suffixes[suffix] = append(c.suffixes[suffix], idx)
+			}
+		}
+	}
+	return c, nil
+}
+
+// Name is used to get the name of the collection
+func (c *ScoredCollection) Name() string {
+	return c.upload.Name
+}
+
+// Upload is used to get the upload the collection was built from
+func (c *ScoredCollection) Upload() *Upload {
+	return c.upload
+}
+
+// Collection is used to get the scored collection
+func (c *ScoredCollection) Collection() *models.Collection {
+	return c.collection
+}
+
+// Ranks is used to get the ranked tokens, sorted by rank. The returned slice must not be modified.
+func (c *ScoredCollection) Ranks() []*oio.RankedToken {
+	return c.ranks
+}
+
+// FindToken is used to find a ranked token by its canonical token identifier, or by the last fields
+// of its token identifier, such as the token ID of EVM tokens. It returns ErrTokenNotFound if no token
+// matches, and ErrAmbiguousToken if the last fields match several tokens.
+func (c *ScoredCollection) FindToken(value string) (*oio.RankedToken, error) {
+	if idx, ok := c.tokens[value]; ok {
+		return c.ranks[idx], nil
+	}
+	switch indexes := c.suffixes[value]; len(indexes) {
+	case 0:
+		return nil, errors.Wrapf(ErrTokenNotFound, "%q", value)
+	case 1:
+		return c.ranks[indexes[0]], nil
+	default:
+		return nil, errors.Wrapf(ErrAmbiguousToken, "%q matches %d tokens", value, len(indexes))
+	}
+}
+
+// ExplainToken is used to break down the score of a ranked token
+func (c *ScoredCollection) ExplainToken(record *oio.RankedToken) (*scoring.TokenExplanation, error) {
+	explainer, ok := c.scorer.(scoring.ITokenExplainer)
+	if !ok {
+		return nil, scoring.ErrExplanationUnsupported
+	}
+	idx, ok := c.tokens[record.TokenIdentifier.String()]
+	if !ok {
+		return nil, errors.Wrapf(ErrTokenNotFound, "%s", record.TokenIdentifier)
+	}
+	return explainer.ExplainToken(c.collection, c.rankedTokens[idx])
+}
+
+// Store keeps scored collections in memory, and optionally writes a snapshot of their uploads
+// to disk after every change. It is safe for concurrent use.
+type Store struct {
+	mu           sync.RWMutex
+	collections  map[string]*ScoredCollection
+	snapshotFile string
+}
+
+// NewStore is the constructor of Store, the collections of the snapshot file are restored if it
+// exists. No snapshot is written if snapshotFile is empty.
+func NewStore(snapshotFile string) (*Store, error) {
+	s := &Store{
+		collections:  map[string]*ScoredCollection{},
+		snapshotFile: snapshotFile,
+	}
+	if snapshotFile == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(snapshotFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var uploads []*Upload
+	if err := json.Unmarshal(data, &uploads); err != nil {
+		return nil, errors.Wrapf(err, "snapshot %s", snapshotFile)
+	}
+	for _, upload := range uploads {
+		collection, err := NewScoredCollection(upload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "snapshot %s: collection %q", snapshotFile, upload.Name)
+		}
+		s.collections[upload.Name] = collection
+	}
+	return s, nil
+}
+
+// Put is used to score and store the collection of an upload, replacing the collection
+// of the same name.
+func (s *Store) Put(upload *Upload) (*ScoredCollection, error) {
+	collection, err := NewScoredCollection(upload)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous, replaced := s.collections[upload.Name]
+	s.collections[upload.Name] = collection
+	if err := s.save(); err != nil {
+		if replaced {
+			s.collections[upload.Name] = previous
+		} else {
+			delete(s.collections, upload.Name)
+		}
+		return nil, err
+	}
+	return collection, nil
+}
+
+// Get is used to get a stored collection by name
+func (s *Store) Get(name string) (*ScoredCollection, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	collection, ok := s.collections[name]
+	return collection, ok
+}
+
+// Delete is used to remove a stored collection, it returns false if there is no such collection
+func (s *Store) Delete(name string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	collection, ok := s.collections[name]
+	if !ok {
+		return false, nil
+	}
+	delete(s.collections, name)
+	if err := s.save(); err != nil {
+		s.collections[name] = collection
+		return false, err
+	}
+	return true, nil
+}
+
+// List is used to get the stored collections, sorted by name
+func (s *Store) List() []*ScoredCollection {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	collections := make([]*ScoredCollection, 0, len(s.collections))
+	for _, collection := range s.collections {
+		collections = append(collections, collection)
+	}
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].Name() < collections[j].Name()
+	})
+	return collections
+}
+
+// save is used to write the snapshot file, it must be called with the lock held. The snapshot
+// is written to a temporary file first, so that a failed write leaves the previous one intact.
+func (s *Store) save() error {
+	if s.snapshotFile == "" {
+		return nil
+	}
+	uploads := make([]*Upload, 0, len(s.collections))
+	for _, collection := range s.collections {
+		uploads = append(uploads, collection.upload)
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		return uploads[i].Name < uploads[j].Name
+	})
+	data, err := json.Marshal(uploads)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(s.snapshotFile), filepath.Base(s.snapshotFile)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), s.snapshotFile)
+}
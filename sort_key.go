@@ -0,0 +1,69 @@
+package openrarity
+
+import (
+	"github.com/Base-Labs/openrarity/models"
+)
+
+// SortKey is one factor of the multifactorial sort used by RarityRanker.
+// Tokens are compared by the first sort key, then by the next one when the values are equal.
+type SortKey struct {
+	// Name describes the sorted value
+	Name string
+	// Value is used to get the value of a token the tokens are sorted by
+	Value func(tokenRarity models.ITokenRarity) float64
+	// Descending reports whether tokens with higher values get the lower ranks
+	Descending bool
+}
+
+// NewSortKey is the constructor of SortKey
+func NewSortKey(
+	name string,
+	value func(tokenRarity models.ITokenRarity) float64,
+	descending bool,
+) SortKey {
+	return SortKey{
+		Name:       name,
+		Value:      value,
+		Descending: descending,
+	}
+}
+
+// SortByUniqueAttributeCount is used to sort tokens by their unique attributes count
+func SortByUniqueAttributeCount(descending bool) SortKey {
+	return NewSortKey(models.UniqueAttributeCountFeatureName, func(tokenRarity models.ITokenRarity) float64 {
+		return float64(tokenRarity.TokenFeatures().UniqueAttributeCount())
+	}, descending)
+}
+
+// SortByScore is used to sort tokens by their rarity score
+func SortByScore(descending bool) SortKey {
+	return NewSortKey("score", func(tokenRarity models.ITokenRarity) float64 {
+		return tokenRarity.Score()
+	}, descending)
+}
+
+// SortByTraitCount is used to sort tokens by their count of non-null traits,
+// not counting the meta trait holding the trait count.
+func SortByTraitCount(descending bool) SortKey {
+	return NewSortKey(models.TraitCountFeatureName, func(tokenRarity models.ITokenRarity) float64 {
+		return models.NewTraitCountExtractor().Extract(tokenRarity.Token(), nil)
+	}, descending)
+}
+
+// SortByFeature is used to sort tokens by the ranking feature with the given name,
+// tokens without the feature are sorted as if its value was zero.
+func SortByFeature(name string, descending bool) SortKey {
+	return NewSortKey(name, func(tokenRarity models.ITokenRarity) float64 {
+		value, _ := tokenRarity.TokenFeatures().Feature(name)
+		return value
+	}, descending)
+}
+
+// DefaultSortKeys is used to get the sort keys of the OpenRarity algorithm: unique attributes
+// count and score, in order, both descending.
+func DefaultSortKeys() []SortKey {
+	return []SortKey{
+		SortByUniqueAttributeCount(true),
+		SortByScore(true),
+	}
+}